@@ -18,8 +18,15 @@ import (
 	"github.com/ViBiOh/httputils/v4/pkg/server"
 	"github.com/ViBiOh/httputils/v4/pkg/telemetry"
 	"github.com/ViBiOh/ketchup/pkg/cap"
+	"github.com/ViBiOh/ketchup/pkg/checker"
+	"github.com/ViBiOh/ketchup/pkg/email"
+	"github.com/ViBiOh/ketchup/pkg/link"
+	"github.com/ViBiOh/ketchup/pkg/notifier"
 	"github.com/ViBiOh/ketchup/pkg/provider/docker"
 	"github.com/ViBiOh/ketchup/pkg/provider/github"
+	"github.com/ViBiOh/ketchup/pkg/scheduler"
+	"github.com/ViBiOh/ketchup/pkg/webhook"
+	mailer "github.com/ViBiOh/mailer/pkg/client"
 )
 
 type configuration struct {
@@ -41,6 +48,14 @@ type configuration struct {
 	github *github.Config
 	docker *docker.Config
 	cap    *cap.Config
+	link   *link.Config
+
+	checker   *checker.Config
+	scheduler *scheduler.Config
+	mailer    *mailer.Config
+	email     *email.Config
+	notifier  *notifier.Config
+	webhook   *webhook.Config
 }
 
 func newConfig() configuration {
@@ -66,6 +81,14 @@ func newConfig() configuration {
 		github: github.Flags(fs, "github"),
 		docker: docker.Flags(fs, "docker"),
 		cap:    cap.Flags(fs, "cap"),
+		link:   link.Flags(fs, "link"),
+
+		checker:   checker.Flags(fs, "checker"),
+		scheduler: scheduler.Flags(fs, "scheduler"),
+		mailer:    mailer.Flags(fs, "mailer"),
+		email:     email.Flags(fs, "email"),
+		notifier:  notifier.Flags(fs, "notifier"),
+		webhook:   webhook.Flags(fs, "webhook"),
 	}
 
 	_ = fs.Parse(os.Args[1:])
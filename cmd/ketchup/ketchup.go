@@ -23,9 +23,12 @@ func main() {
 	services, err := newServices(clients.health.EndCtx(), config, clients)
 	logger.FatalfOnErr(ctx, err, "services")
 
+	defer services.Close(ctx)
+
 	port := newPort(clients, services)
 
 	go services.server.Start(clients.health.EndCtx(), port)
+	services.Start(clients.health.DoneCtx(), clients.telemetry.TracerProvider())
 
 	clients.health.WaitForTermination(services.server.Done())
 	health.WaitAll(services.server.Done())
@@ -4,17 +4,24 @@ import (
 	"net/http"
 
 	"github.com/ViBiOh/httputils/v4/pkg/httputils"
+	"github.com/ViBiOh/ketchup/pkg/link"
 	"github.com/ViBiOh/ketchup/pkg/middleware"
 )
 
 func newPort(clients clients, services services) http.Handler {
 	authMux := http.NewServeMux()
 	authMux.Handle("/ketchups/{id...}", services.ketchup.Ketchups())
+	authMux.Handle("/channels/{id...}", services.ketchup.Channels())
+	authMux.Handle("/feed-token", services.ketchup.FeedToken())
+	authMux.Handle("/settings", services.ketchup.Settings())
 	authMux.Handle("/", services.renderer.Handler(services.ketchup.TemplateFunc))
 
 	mux := http.NewServeMux()
 	mux.Handle("/signup", services.ketchup.Signup())
 	mux.Handle("/logout", services.ketchup.Logout())
+	mux.Handle("GET /app/feed/{token}", services.ketchup.Feed())
+	mux.Handle("GET "+link.Path, services.renderer.Handler(services.ketchup.ActionTemplateFunc))
+	mux.Handle("POST "+link.Path, services.ketchup.Action())
 	mux.Handle("/app/", http.StripPrefix("/app", services.authMiddleware.Middleware(middleware.New(services.user).Middleware(authMux))))
 
 	services.renderer.RegisterMux(mux, services.ketchup.PublicTemplateFunc)
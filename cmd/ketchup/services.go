@@ -4,6 +4,7 @@ import (
 	"context"
 	"embed"
 	"fmt"
+	"log/slog"
 
 	"github.com/ViBiOh/auth/v3/pkg/cookie"
 	authMiddleware "github.com/ViBiOh/auth/v3/pkg/middleware"
@@ -14,18 +15,38 @@ import (
 	"github.com/ViBiOh/httputils/v4/pkg/owasp"
 	"github.com/ViBiOh/httputils/v4/pkg/renderer"
 	"github.com/ViBiOh/httputils/v4/pkg/server"
+	"github.com/ViBiOh/httputils/v4/pkg/telemetry"
+	"github.com/ViBiOh/ketchup/pkg/checker"
+	"github.com/ViBiOh/ketchup/pkg/email"
 	"github.com/ViBiOh/ketchup/pkg/ketchup"
+	"github.com/ViBiOh/ketchup/pkg/link"
+	"github.com/ViBiOh/ketchup/pkg/metric"
+	"github.com/ViBiOh/ketchup/pkg/model"
+	"github.com/ViBiOh/ketchup/pkg/notifier"
 	"github.com/ViBiOh/ketchup/pkg/provider/docker"
 	"github.com/ViBiOh/ketchup/pkg/provider/github"
 	"github.com/ViBiOh/ketchup/pkg/provider/helm"
 	"github.com/ViBiOh/ketchup/pkg/provider/npm"
 	"github.com/ViBiOh/ketchup/pkg/provider/pypi"
+	"github.com/ViBiOh/ketchup/pkg/scheduler"
+	channelService "github.com/ViBiOh/ketchup/pkg/service/channel"
 	ketchupService "github.com/ViBiOh/ketchup/pkg/service/ketchup"
+	notificationService "github.com/ViBiOh/ketchup/pkg/service/notification"
+	releaseService "github.com/ViBiOh/ketchup/pkg/service/release"
+	reportService "github.com/ViBiOh/ketchup/pkg/service/report"
 	repositoryService "github.com/ViBiOh/ketchup/pkg/service/repository"
 	userService "github.com/ViBiOh/ketchup/pkg/service/user"
+	channelStore "github.com/ViBiOh/ketchup/pkg/store/channel"
 	ketchupStore "github.com/ViBiOh/ketchup/pkg/store/ketchup"
+	notificationStore "github.com/ViBiOh/ketchup/pkg/store/notification"
+	releaseStore "github.com/ViBiOh/ketchup/pkg/store/release"
+	reportStore "github.com/ViBiOh/ketchup/pkg/store/report"
 	repositoryStore "github.com/ViBiOh/ketchup/pkg/store/repository"
 	userStore "github.com/ViBiOh/ketchup/pkg/store/user"
+	webhookStore "github.com/ViBiOh/ketchup/pkg/store/webhook"
+	"github.com/ViBiOh/ketchup/pkg/webhook"
+	mailer "github.com/ViBiOh/mailer/pkg/client"
+	"go.opentelemetry.io/otel/trace"
 )
 
 //go:embed templates static
@@ -39,6 +60,10 @@ type services struct {
 	cors           cors.Service
 	authMiddleware authMiddleware.Service
 	owasp          owasp.Service
+	checker        checker.Service
+	scheduler      scheduler.Service
+	notifier       notifier.Service
+	mailer         mailer.Service
 }
 
 func newServices(ctx context.Context, config configuration, clients clients) (services, error) {
@@ -64,6 +89,8 @@ func newServices(ctx context.Context, config configuration, clients clients) (se
 	repositoryService := repositoryService.New(repositoryStore.New(clients.db), githubService, helmService, dockerService, npmService, pypiService)
 
 	ketchupService := ketchupService.New(ketchupStore.New(clients.db), repositoryService)
+	channelService := channelService.New(channelStore.New(clients.db))
+	releaseService := releaseService.New(releaseStore.New(clients.db))
 
 	output.renderer, err = renderer.New(ctx, config.renderer, content, ketchup.FuncMap, clients.telemetry.MeterProvider(), clients.telemetry.TracerProvider())
 	if err != nil {
@@ -71,7 +98,82 @@ func newServices(ctx context.Context, config configuration, clients clients) (se
 	}
 
 	output.user = userService.New(userStore.New(clients.db), authStorage)
-	output.ketchup = ketchup.New(ctx, output.renderer, ketchupService, output.user, repositoryService, clients.cap, basicProvider, clients.redis, clients.telemetry.TracerProvider())
+	reportService := reportService.New(reportStore.New(clients.db))
+
+	metricService, err := metric.New(clients.telemetry.MeterProvider())
+	if err != nil {
+		return output, fmt.Errorf("metric: %w", err)
+	}
+
+	output.checker = checker.New(config.checker, repositoryService, releaseService, reportService, clients.redis, metricService)
+
+	linkService := link.New(config.link)
+
+	output.ketchup = ketchup.New(ctx, output.renderer, ketchupService, channelService, releaseService, output.user, repositoryService, clients.cap, linkService, reportService, basicProvider, clients.redis, clients.telemetry.TracerProvider())
+
+	output.scheduler, err = scheduler.New(config.scheduler, clients.redis)
+	if err != nil {
+		return output, fmt.Errorf("scheduler: %w", err)
+	}
+
+	if !output.scheduler.Enabled() {
+		return output, nil
+	}
+
+	output.mailer, err = mailer.New(ctx, config.mailer, clients.telemetry.MeterProvider(), clients.telemetry.TracerProvider())
+	if err != nil {
+		return output, fmt.Errorf("mailer: %w", err)
+	}
+
+	webhookService := webhook.New(config.webhook, webhookStore.New(clients.db))
+
+	notifiers := map[model.ChannelKind]model.Notifier{
+		model.Webhook: webhookService,
+		model.Slack:   webhookService,
+		model.Discord: webhookService,
+		model.Ntfy:    webhookService,
+		model.Gotify:  webhookService,
+	}
+
+	if output.mailer.Enabled() {
+		notifiers[model.Email] = email.New(config.email, output.mailer, linkService)
+	} else {
+		slog.WarnContext(ctx, "mailer is not configured")
+	}
+
+	output.notifier = notifier.New(config.notifier, repositoryService, releaseService, ketchupService, output.user, channelService, notificationService.New(notificationStore.New(clients.db)), notifiers, output.checker, reportService, metricService, helmService)
 
 	return output, nil
 }
+
+func (s services) Start(ctx context.Context, tracerProvider trace.TracerProvider) {
+	notify := func(ctx context.Context) (err error) {
+		ctx, end := telemetry.StartSpan(ctx, tracerProvider.Tracer("notifier"), "notifier")
+		defer end(&err)
+
+		_, err = s.notifier.Notify(ctx)
+
+		return err
+	}
+
+	if s.checker.Background() {
+		var onReleases func(context.Context)
+
+		// Releases found by the background checks are notified right away, for the immediate ketchups
+		if s.scheduler.Enabled() {
+			onReleases = func(ctx context.Context) {
+				s.scheduler.Run(ctx, notify)
+			}
+		}
+
+		go s.checker.Start(ctx, onReleases)
+	}
+
+	go s.scheduler.Start(ctx, notify)
+}
+
+func (s services) Close(ctx context.Context) {
+	if s.scheduler.Enabled() {
+		s.mailer.Close(ctx)
+	}
+}
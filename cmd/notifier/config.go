@@ -8,9 +8,13 @@ import (
 	"github.com/ViBiOh/httputils/v4/pkg/db"
 	"github.com/ViBiOh/httputils/v4/pkg/logger"
 	"github.com/ViBiOh/httputils/v4/pkg/telemetry"
+	"github.com/ViBiOh/ketchup/pkg/checker"
+	"github.com/ViBiOh/ketchup/pkg/email"
+	"github.com/ViBiOh/ketchup/pkg/link"
 	"github.com/ViBiOh/ketchup/pkg/notifier"
 	"github.com/ViBiOh/ketchup/pkg/provider/docker"
 	"github.com/ViBiOh/ketchup/pkg/provider/github"
+	"github.com/ViBiOh/ketchup/pkg/webhook"
 	mailer "github.com/ViBiOh/mailer/pkg/client"
 )
 
@@ -20,10 +24,14 @@ type configuration struct {
 
 	db *db.Config
 
+	checker  *checker.Config
 	github   *github.Config
 	docker   *docker.Config
 	mailer   *mailer.Config
+	email    *email.Config
+	link     *link.Config
 	notifier *notifier.Config
+	webhook  *webhook.Config
 }
 
 func newConfig() configuration {
@@ -36,10 +44,14 @@ func newConfig() configuration {
 
 		db: db.Flags(fs, "db"),
 
+		checker:  checker.Flags(fs, "checker"),
 		github:   github.Flags(fs, "github"),
 		docker:   docker.Flags(fs, "docker"),
 		mailer:   mailer.Flags(fs, "mailer"),
+		email:    email.Flags(fs, "email"),
+		link:     link.Flags(fs, "link"),
 		notifier: notifier.Flags(fs, "notifier"),
+		webhook:  webhook.Flags(fs, "webhook"),
 	}
 
 	_ = fs.Parse(os.Args[1:])
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"log/slog"
 	"os"
 
@@ -29,7 +30,18 @@ func main() {
 	ctx, end := telemetry.StartSpan(ctx, clients.telemetry.TracerProvider().Tracer("notifier"), "notifier")
 	defer end(&err)
 
-	if err = services.notifier.Notify(ctx); err != nil {
+	report, err := services.notifier.Notify(ctx)
+
+	if config.notifier.DryRun {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+
+		if encodeErr := encoder.Encode(report); encodeErr != nil {
+			slog.LogAttrs(ctx, slog.LevelError, "encode report", slog.Any("error", encodeErr))
+		}
+	}
+
+	if err != nil {
 		slog.LogAttrs(ctx, slog.LevelError, "notify", slog.Any("error", err))
 		os.Exit(1)
 	}
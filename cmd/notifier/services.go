@@ -3,23 +3,40 @@ package main
 import (
 	"context"
 	"fmt"
+	"log/slog"
 
+	"github.com/ViBiOh/ketchup/pkg/checker"
+	"github.com/ViBiOh/ketchup/pkg/email"
+	"github.com/ViBiOh/ketchup/pkg/link"
+	"github.com/ViBiOh/ketchup/pkg/metric"
+	"github.com/ViBiOh/ketchup/pkg/model"
 	"github.com/ViBiOh/ketchup/pkg/notifier"
 	"github.com/ViBiOh/ketchup/pkg/provider/docker"
 	"github.com/ViBiOh/ketchup/pkg/provider/github"
 	"github.com/ViBiOh/ketchup/pkg/provider/helm"
 	"github.com/ViBiOh/ketchup/pkg/provider/npm"
 	"github.com/ViBiOh/ketchup/pkg/provider/pypi"
+	channelService "github.com/ViBiOh/ketchup/pkg/service/channel"
 	ketchupService "github.com/ViBiOh/ketchup/pkg/service/ketchup"
+	notificationService "github.com/ViBiOh/ketchup/pkg/service/notification"
+	releaseService "github.com/ViBiOh/ketchup/pkg/service/release"
+	reportService "github.com/ViBiOh/ketchup/pkg/service/report"
 	repositoryService "github.com/ViBiOh/ketchup/pkg/service/repository"
 	userService "github.com/ViBiOh/ketchup/pkg/service/user"
+	channelStore "github.com/ViBiOh/ketchup/pkg/store/channel"
 	ketchupStore "github.com/ViBiOh/ketchup/pkg/store/ketchup"
+	notificationStore "github.com/ViBiOh/ketchup/pkg/store/notification"
+	releaseStore "github.com/ViBiOh/ketchup/pkg/store/release"
+	reportStore "github.com/ViBiOh/ketchup/pkg/store/report"
 	repositoryStore "github.com/ViBiOh/ketchup/pkg/store/repository"
 	userStore "github.com/ViBiOh/ketchup/pkg/store/user"
+	webhookStore "github.com/ViBiOh/ketchup/pkg/store/webhook"
+	"github.com/ViBiOh/ketchup/pkg/webhook"
 	mailer "github.com/ViBiOh/mailer/pkg/client"
 )
 
 type services struct {
+	checker  checker.Service
 	notifier notifier.Service
 	mailer   mailer.Service
 }
@@ -35,15 +52,44 @@ func newServices(ctx context.Context, config configuration, clients clients) (se
 	pypiService := pypi.New()
 
 	repositoryService := repositoryService.New(repositoryStore.New(clients.db), githubService, helmService, dockerService, npmService, pypiService)
+	releaseService := releaseService.New(releaseStore.New(clients.db))
 	ketchupService := ketchupService.New(ketchupStore.New(clients.db), repositoryService)
 	userService := userService.New(userStore.New(clients.db), nil)
+	channelService := channelService.New(channelStore.New(clients.db))
+	notificationService := notificationService.New(notificationStore.New(clients.db))
+
+	reportService := reportService.New(reportStore.New(clients.db))
+
+	metricService, err := metric.New(clients.telemetry.MeterProvider())
+	if err != nil {
+		return output, fmt.Errorf("metric: %w", err)
+	}
+
+	output.checker = checker.New(config.checker, repositoryService, releaseService, reportService, nil, metricService)
 
 	output.mailer, err = mailer.New(ctx, config.mailer, clients.telemetry.MeterProvider(), clients.telemetry.TracerProvider())
 	if err != nil {
 		return output, fmt.Errorf("mailer: %w", err)
 	}
 
-	output.notifier = notifier.New(config.notifier, repositoryService, ketchupService, userService, output.mailer, helmService)
+	webhookService := webhook.New(config.webhook, webhookStore.New(clients.db))
+
+	notifiers := map[model.ChannelKind]model.Notifier{
+		model.Webhook: webhookService,
+		model.Slack:   webhookService,
+		model.Discord: webhookService,
+		model.Ntfy:    webhookService,
+		model.Gotify:  webhookService,
+	}
+
+	// Emails are rendered in dry-run even without a mailer, for previewing them
+	if output.mailer.Enabled() || config.notifier.DryRun {
+		notifiers[model.Email] = email.New(config.email, output.mailer, link.New(config.link))
+	} else {
+		slog.WarnContext(ctx, "mailer is not configured")
+	}
+
+	output.notifier = notifier.New(config.notifier, repositoryService, releaseService, ketchupService, userService, channelService, notificationService, notifiers, output.checker, reportService, metricService, helmService)
 
 	return output, nil
 }
@@ -0,0 +1,217 @@
+package checker
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ViBiOh/flags"
+	httpModel "github.com/ViBiOh/httputils/v4/pkg/model"
+	"github.com/ViBiOh/ketchup/pkg/metric"
+	"github.com/ViBiOh/ketchup/pkg/model"
+)
+
+const recordClass = "record"
+
+var (
+	pageSize  = uint(20)
+	errRecord = errors.New("record releases")
+)
+
+type Service struct {
+	repository model.RepositoryService
+	release    model.ReleaseService
+	report     model.ReportService
+	redis      model.Redis
+	metric     metric.Service
+	intervals  map[model.RepositoryKind]time.Duration
+	clock      func() time.Time
+	background bool
+}
+
+type Config struct {
+	Github     time.Duration
+	Helm       time.Duration
+	Docker     time.Duration
+	NPM        time.Duration
+	Pypi       time.Duration
+	Background bool
+}
+
+func Flags(fs *flag.FlagSet, prefix string) *Config {
+	var config Config
+
+	flags.New("Background", "Check repositories continuously from the web server, the notifier only reading recorded releases, immediate notifications requiring it along with -schedulerCron").Prefix(prefix).DocPrefix("checker").BoolVar(fs, &config.Background, false, nil)
+	flags.New("Github", "Interval between checks of GitHub repositories, disabled if zero").Prefix(prefix).DocPrefix("checker").DurationVar(fs, &config.Github, time.Hour, nil)
+	flags.New("Helm", "Interval between checks of Helm repositories, disabled if zero").Prefix(prefix).DocPrefix("checker").DurationVar(fs, &config.Helm, time.Hour, nil)
+	flags.New("Docker", "Interval between checks of Docker repositories, disabled if zero").Prefix(prefix).DocPrefix("checker").DurationVar(fs, &config.Docker, 6*time.Hour, nil)
+	flags.New("Npm", "Interval between checks of NPM repositories, disabled if zero").Prefix(prefix).DocPrefix("checker").DurationVar(fs, &config.NPM, time.Hour, nil)
+	flags.New("Pypi", "Interval between checks of Pypi repositories, disabled if zero").Prefix(prefix).DocPrefix("checker").DurationVar(fs, &config.Pypi, time.Hour, nil)
+
+	return &config
+}
+
+func New(config *Config, repositoryService model.RepositoryService, releaseService model.ReleaseService, reportService model.ReportService, redis model.Redis, metricService metric.Service) Service {
+	return Service{
+		repository: repositoryService,
+		release:    releaseService,
+		report:     reportService,
+		redis:      redis,
+		metric:     metricService,
+		clock:      time.Now,
+		background: config.Background,
+		intervals: map[model.RepositoryKind]time.Duration{
+			model.Github: config.Github,
+			model.Helm:   config.Helm,
+			model.Docker: config.Docker,
+			model.NPM:    config.NPM,
+			model.Pypi:   config.Pypi,
+		},
+	}
+}
+
+// Background tells if repositories are checked by the web server rather than by each notifier run
+func (s Service) Background() bool {
+	return s.background
+}
+
+// Start checks each kind of repositories on its own interval until the context is done, calling onReleases after a check that found new ones
+func (s Service) Start(ctx context.Context, onReleases func(context.Context)) {
+	var wg sync.WaitGroup
+
+	for kind, interval := range s.intervals {
+		if interval <= 0 {
+			slog.LogAttrs(ctx, slog.LevelWarn, "checker disabled", slog.String("kind", kind.String()))
+			continue
+		}
+
+		wg.Go(func() {
+			s.loop(ctx, kind, interval, onReleases)
+		})
+	}
+
+	wg.Wait()
+}
+
+// loop aligns the checks on the interval, so every instance wakes up at the same time and only the one holding the lock checks
+func (s Service) loop(ctx context.Context, kind model.RepositoryKind, interval time.Duration, onReleases func(context.Context)) {
+	lockName := "ketchup:checker:" + strings.ToLower(kind.String())
+
+	for {
+		now := s.clock()
+		timer := time.NewTimer(now.Truncate(interval).Add(interval).Sub(now))
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		var newReleases uint
+
+		acquired, err := s.redis.Exclusive(ctx, lockName, interval, func(ctx context.Context) error {
+			start := s.clock()
+
+			reports, _, err := s.check(ctx, true, kind)
+			s.saveReports(ctx, start, reports, err)
+
+			newReleases = reports[kind].NewReleases
+
+			return err
+		})
+
+		switch {
+		case err != nil:
+			slog.LogAttrs(ctx, slog.LevelError, "check repositories", slog.String("kind", kind.String()), slog.Any("error", err))
+		case !acquired:
+			slog.LogAttrs(ctx, slog.LevelDebug, "Repositories already checked by another instance", slog.String("kind", kind.String()))
+		}
+
+		// Outside of the lock of the check, for the notifications of immediate ketchups not to wait for the next notifier run
+		if newReleases != 0 && onReleases != nil {
+			onReleases(ctx)
+		}
+	}
+}
+
+// CheckAll checks every repository once, for the notifier runs not relying on the background checks
+func (s Service) CheckAll(ctx context.Context) (map[model.RepositoryKind]model.CheckReport, error) {
+	start := s.clock()
+
+	reports, _, err := s.check(ctx, true)
+	s.saveReports(ctx, start, reports, err)
+
+	return reports, err
+}
+
+// Preview checks every repository once without recording anything, giving the releases found for a dry-run of the notifier
+func (s Service) Preview(ctx context.Context) (map[model.RepositoryKind]model.CheckReport, []model.Release, error) {
+	return s.check(ctx, false)
+}
+
+// saveReports keeps the last check of each kind, for the status in the UI
+func (s Service) saveReports(ctx context.Context, start time.Time, reports map[model.RepositoryKind]model.CheckReport, err error) {
+	for kind, check := range reports {
+		report := model.NewReport(strings.ToLower(kind.String()), start)
+		report.AddCheck(kind, check)
+		report.Duration = check.Duration
+
+		if err != nil {
+			report.Error = err.Error()
+		}
+
+		if saveErr := s.report.Save(ctx, report); saveErr != nil {
+			slog.LogAttrs(ctx, slog.LevelError, "save check report", slog.String("kind", kind.String()), slog.Any("error", saveErr))
+		}
+	}
+}
+
+// errorClass groups the errors of the checks by cause, for the reports
+func errorClass(err error) string {
+	var netErr net.Error
+
+	switch {
+	case errors.Is(err, errRecord):
+		return recordClass
+	case errors.Is(err, context.DeadlineExceeded), errors.As(err, &netErr) && netErr.Timeout():
+		return "timeout"
+	case errors.Is(err, context.Canceled):
+		return "canceled"
+	case errors.Is(err, httpModel.ErrNotFound):
+		return "not_found"
+	case errors.Is(err, httpModel.ErrUnauthorized), errors.Is(err, httpModel.ErrForbidden):
+		return "denied"
+	default:
+		return "other"
+	}
+}
+
+// recordReleases advances the repository to its new versions and records the releases in a single transaction, for the notifier to pick them up
+func (s Service) recordReleases(ctx context.Context, repo model.Repository, releases []model.Release) error {
+	newReleases, _ := model.SplitYankedReleases(releases)
+
+	return s.release.DoAtomic(ctx, func(ctx context.Context) error {
+		if len(newReleases) != 0 {
+			for _, release := range newReleases {
+				repo.Versions[release.Pattern] = release.Version.Name
+			}
+
+			if err := s.repository.Update(ctx, repo); err != nil {
+				return fmt.Errorf("update repository `%s`: %w", repo.Name, err)
+			}
+		}
+
+		if err := s.release.Record(ctx, releases); err != nil {
+			return fmt.Errorf("record releases of `%s`: %w", repo.Name, err)
+		}
+
+		return nil
+	})
+}
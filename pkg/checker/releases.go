@@ -0,0 +1,233 @@
+package checker
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ViBiOh/httputils/v4/pkg/concurrent"
+	"github.com/ViBiOh/ketchup/pkg/model"
+	"github.com/ViBiOh/ketchup/pkg/semver"
+)
+
+// check looks for the new releases of the repositories, recording them or, when not, giving them back without changing anything
+func (s Service) check(ctx context.Context, record bool, kinds ...model.RepositoryKind) (map[model.RepositoryKind]model.CheckReport, []model.Release, error) {
+	var mutex sync.Mutex
+	var last model.Identifier
+	var detected []model.Release
+
+	start := s.clock()
+	reports := make(map[model.RepositoryKind]model.CheckReport)
+
+	// addResult accounts the check of one repository in the report of its kind
+	addResult := func(kind model.RepositoryKind, newReleases int, err error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		report := reports[kind]
+		report.Repositories++
+		report.NewReleases += uint(newReleases)
+
+		if err != nil {
+			if report.Failures == nil {
+				report.Failures = make(map[string]uint)
+			}
+
+			report.Failures[errorClass(err)]++
+		}
+
+		reports[kind] = report
+	}
+
+	knownYanked, err := s.knownYankedVersions(ctx, kinds)
+	if err != nil {
+		return nil, nil, fmt.Errorf("fetch yanked versions: %w", err)
+	}
+
+	wg := concurrent.NewLimiter(4)
+
+	for {
+		repositories, err := s.repository.List(ctx, pageSize, last, kinds...)
+		if err != nil {
+			wg.Wait()
+			return nil, nil, fmt.Errorf("fetch repositories: %w", err)
+		}
+
+		for _, repo := range repositories {
+			wg.Go(func() {
+				releases, err := s.getNewRepositoryReleases(ctx, repo)
+
+				newReleases, yankedReleases := model.SplitYankedReleases(releases)
+				yankedReleases = unknownYankedReleases(yankedReleases, knownYanked[repo.ID])
+
+				if err == nil && !record {
+					mutex.Lock()
+					detected = append(detected, slices.Concat(newReleases, yankedReleases)...)
+					mutex.Unlock()
+				} else if err == nil && len(newReleases)+len(yankedReleases) != 0 {
+					if err = s.recordReleases(ctx, repo, slices.Concat(newReleases, yankedReleases)); err != nil {
+						slog.LogAttrs(ctx, slog.LevelError, "record releases", slog.String("repo", repo.String()), slog.Any("error", err))
+
+						err = fmt.Errorf("%w: %w", errRecord, err)
+						newReleases = nil
+					}
+				}
+
+				addResult(repo.Kind, len(newReleases), err)
+				s.metric.RepositoryChecked(ctx, repo.Kind, len(newReleases), err)
+			})
+		}
+
+		if len(repositories) < int(pageSize) {
+			break
+		}
+
+		last = repositories[len(repositories)-1].ID
+	}
+
+	wg.Wait()
+
+	duration := s.clock().Sub(start)
+
+	var failed uint
+
+	for kind, report := range reports {
+		report.Duration = duration
+		reports[kind] = report
+
+		failed += report.Failures[recordClass]
+
+		slog.LogAttrs(ctx, slog.LevelInfo, "Repositories checked", slog.String("kind", kind.String()), slog.Uint64("count", uint64(report.Repositories)), slog.Uint64("new", uint64(report.NewReleases)), slog.Uint64("failures", uint64(report.FailuresCount())))
+	}
+
+	if failed != 0 {
+		return reports, detected, fmt.Errorf("record releases of %d repositories", failed)
+	}
+
+	return reports, detected, nil
+}
+
+// knownYankedVersions indexes by repository the versions already recorded as yanked, for not recording them again at each check
+func (s Service) knownYankedVersions(ctx context.Context, kinds []model.RepositoryKind) (map[model.Identifier]map[string]struct{}, error) {
+	if len(kinds) != 0 && !slices.ContainsFunc(kinds, model.RepositoryKind.SupportsYank) {
+		return nil, nil
+	}
+
+	yankedReleases, err := s.release.ListYanked(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make(map[model.Identifier]map[string]struct{})
+
+	for _, release := range yankedReleases {
+		versions, ok := output[release.Repository.ID]
+		if !ok {
+			versions = make(map[string]struct{})
+			output[release.Repository.ID] = versions
+		}
+
+		versions[release.Version.Name] = struct{}{}
+	}
+
+	return output, nil
+}
+
+func unknownYankedReleases(releases []model.Release, known map[string]struct{}) []model.Release {
+	var output []model.Release
+
+	for _, release := range releases {
+		if _, ok := known[release.Version.Name]; !ok {
+			output = append(output, release)
+		}
+	}
+
+	return output
+}
+
+func (s Service) getNewRepositoryReleases(ctx context.Context, repo model.Repository) ([]model.Release, error) {
+	start := time.Now()
+	versions, yankedVersions, err := s.repository.Versions(ctx, repo)
+
+	var failure string
+	if err != nil {
+		failure = errorClass(err)
+	}
+
+	s.metric.ProviderRequest(ctx, repo.Kind, time.Since(start), failure)
+
+	if err != nil {
+		slog.LogAttrs(ctx, slog.LevelError, "get latest versions", slog.String("name", repo.Name), slog.String("kind", repo.Kind.String()), slog.Any("error", err))
+		return nil, err
+	}
+
+	var releases []model.Release
+
+	for pattern, version := range versions {
+		releases = appendVersion(ctx, releases, version, repo, pattern, repo.Versions[pattern])
+	}
+
+	for index, release := range releases {
+		releases[index] = s.addReleaseDetail(ctx, release)
+	}
+
+	if repo.Kind.SupportsYank() {
+		releases = append(releases, getYankedRepositoryReleases(repo, yankedVersions)...)
+	}
+
+	return releases, nil
+}
+
+func (s Service) addReleaseDetail(ctx context.Context, release model.Release) model.Release {
+	detail, err := s.repository.ReleaseDetail(ctx, release.Repository, release.Version.Name)
+	if err != nil {
+		slog.LogAttrs(ctx, slog.LevelWarn, "get release detail", slog.String("repo", release.Repository.String()), slog.String("version", release.Version.Name), slog.Any("error", err))
+		return release
+	}
+
+	return release.SetDetail(detail)
+}
+
+func getYankedRepositoryReleases(repo model.Repository, yankedVersions []string) []model.Release {
+	var releases []model.Release
+
+	for _, yankedVersion := range yankedVersions {
+		version, err := repo.ParseVersion(yankedVersion)
+		if err != nil {
+			continue
+		}
+
+		releases = append(releases, model.NewRelease(repo, "", version.Yank()))
+	}
+
+	return releases
+}
+
+func appendVersion(ctx context.Context, releases []model.Release, upstreamVersion semver.Version, repo model.Repository, repoPattern, repoVersionName string) []model.Release {
+	if upstreamVersion.Name == repoVersionName {
+		return releases
+	}
+
+	compiledPattern, err := semver.ParsePattern(repoPattern)
+	if err != nil {
+		slog.LogAttrs(ctx, slog.LevelError, "parse pattern", slog.String("pattern", repoPattern), slog.Any("error", err))
+		return releases
+	}
+
+	repositoryVersion, err := repo.ParseVersion(repoVersionName)
+	if err != nil {
+		slog.LogAttrs(ctx, slog.LevelError, "parse version", slog.String("version", repoVersionName), slog.String("repo", semver.ExtractName(repo.Name)), slog.Any("error", err))
+		return releases
+	}
+
+	if !compiledPattern.Check(upstreamVersion) || !upstreamVersion.IsGreater(repositoryVersion) {
+		return releases
+	}
+
+	slog.LogAttrs(ctx, slog.LevelInfo, "New version available", slog.String("pattern", repoPattern), slog.String("repo", repo.String()), slog.String("version", upstreamVersion.Name))
+
+	return append(releases, model.NewRelease(repo, repoPattern, upstreamVersion))
+}
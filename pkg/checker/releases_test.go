@@ -0,0 +1,376 @@
+package checker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ViBiOh/ketchup/pkg/mocks"
+	"github.com/ViBiOh/ketchup/pkg/model"
+	"github.com/ViBiOh/ketchup/pkg/semver"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	repositoryName    = "vibioh/ketchup"
+	repositoryVersion = "1.0.0"
+)
+
+func safeParse(version string) semver.Version {
+	output, err := semver.Parse(version, "")
+	if err != nil {
+		fmt.Println(err)
+	}
+	return output
+}
+
+func safePEP440(version string) semver.Version {
+	output, err := semver.ParsePEP440(version)
+	if err != nil {
+		fmt.Println(err)
+	}
+	return output
+}
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		kinds   []model.RepositoryKind
+		preview bool
+	}
+
+	releaseDetail := model.ReleaseDetail{
+		PublishedAt: time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC),
+		Notes:       "Bug fixes",
+	}
+
+	cases := map[string]struct {
+		args         args
+		want         map[model.RepositoryKind]model.CheckReport
+		wantDetected int
+		wantErr      error
+	}{
+		"list error": {
+			args{},
+			nil,
+			0,
+			errors.New("failed"),
+		},
+		"github error": {
+			args{},
+			map[model.RepositoryKind]model.CheckReport{
+				model.Github: {Repositories: 1, Failures: map[string]uint{"other": 1}},
+			},
+			0,
+			nil,
+		},
+		"same version": {
+			args{
+				kinds: []model.RepositoryKind{model.Github},
+			},
+			map[model.RepositoryKind]model.CheckReport{
+				model.Github: {Repositories: 1},
+			},
+			0,
+			nil,
+		},
+		"success": {
+			args{
+				kinds: []model.RepositoryKind{model.Github},
+			},
+			map[model.RepositoryKind]model.CheckReport{
+				model.Github: {Repositories: 1, NewReleases: 1},
+			},
+			0,
+			nil,
+		},
+		"record error": {
+			args{},
+			map[model.RepositoryKind]model.CheckReport{
+				model.Github: {Repositories: 1, Failures: map[string]uint{"record": 1}},
+			},
+			0,
+			errors.New("record releases of 1 repositories"),
+		},
+		"yanked error": {
+			args{
+				kinds: []model.RepositoryKind{model.Pypi},
+			},
+			nil,
+			0,
+			errors.New("fetch yanked versions"),
+		},
+		"known yanked": {
+			args{
+				kinds: []model.RepositoryKind{model.Pypi},
+			},
+			map[model.RepositoryKind]model.CheckReport{
+				model.Pypi: {Repositories: 1},
+			},
+			0,
+			nil,
+		},
+		"preview": {
+			args{
+				kinds:   []model.RepositoryKind{model.Github},
+				preview: true,
+			},
+			map[model.RepositoryKind]model.CheckReport{
+				model.Github: {Repositories: 1, NewReleases: 1},
+			},
+			1,
+			nil,
+		},
+		"new yanked": {
+			args{
+				kinds: []model.RepositoryKind{model.Pypi},
+			},
+			map[model.RepositoryKind]model.CheckReport{
+				model.Pypi: {Repositories: 1},
+			},
+			0,
+			nil,
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			mockRepositoryService := mocks.NewRepositoryService(ctrl)
+			mockReleaseService := mocks.NewReleaseService(ctrl)
+
+			now := time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)
+
+			instance := Service{
+				repository: mockRepositoryService,
+				release:    mockReleaseService,
+				clock:      func() time.Time { return now },
+			}
+
+			var kinds []any
+			for _, kind := range testCase.args.kinds {
+				kinds = append(kinds, kind)
+			}
+
+			pypiRepository := model.NewRepository(model.Identifier(2), model.Pypi, "ketchup", "").AddVersion(model.DefaultPattern, repositoryVersion)
+
+			switch intention {
+			case "list error":
+				mockReleaseService.EXPECT().ListYanked(gomock.Any()).Return(nil, nil)
+				mockRepositoryService.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any(), kinds...).Return(nil, errors.New("failed"))
+			case "github error":
+				mockReleaseService.EXPECT().ListYanked(gomock.Any()).Return(nil, nil)
+				mockRepositoryService.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any(), kinds...).Return([]model.Repository{
+					model.NewGithubRepository(model.Identifier(1), repositoryName).AddVersion(model.DefaultPattern, repositoryVersion),
+				}, nil)
+				mockRepositoryService.EXPECT().Versions(gomock.Any(), gomock.Any()).Return(nil, nil, errors.New("failed"))
+			case "same version":
+				mockRepositoryService.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any(), kinds...).Return([]model.Repository{
+					model.NewGithubRepository(model.Identifier(1), repositoryName).AddVersion(model.DefaultPattern, repositoryVersion),
+				}, nil)
+				mockRepositoryService.EXPECT().Versions(gomock.Any(), gomock.Any()).Return(map[string]semver.Version{
+					model.DefaultPattern: safeParse(repositoryVersion),
+				}, nil, nil)
+			case "preview":
+				mockRepositoryService.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any(), kinds...).Return([]model.Repository{
+					model.NewGithubRepository(model.Identifier(1), repositoryName).AddVersion(model.DefaultPattern, repositoryVersion),
+				}, nil)
+				mockRepositoryService.EXPECT().Versions(gomock.Any(), gomock.Any()).Return(map[string]semver.Version{
+					model.DefaultPattern: safeParse("1.1.0"),
+				}, nil, nil)
+				mockRepositoryService.EXPECT().ReleaseDetail(gomock.Any(), gomock.Any(), "1.1.0").Return(releaseDetail, nil)
+			case "success", "record error":
+				if intention == "record error" {
+					mockReleaseService.EXPECT().ListYanked(gomock.Any()).Return(nil, nil)
+				}
+
+				mockRepositoryService.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any(), kinds...).Return([]model.Repository{
+					model.NewGithubRepository(model.Identifier(1), repositoryName).AddVersion(model.DefaultPattern, repositoryVersion),
+				}, nil)
+				mockRepositoryService.EXPECT().Versions(gomock.Any(), gomock.Any()).Return(map[string]semver.Version{
+					model.DefaultPattern: safeParse("1.1.0"),
+					"1.0":                safeParse("1.0"),
+				}, nil, nil)
+				mockRepositoryService.EXPECT().ReleaseDetail(gomock.Any(), gomock.Any(), "1.1.0").Return(releaseDetail, nil)
+
+				mockReleaseService.EXPECT().DoAtomic(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, action func(context.Context) error) error {
+					return action(ctx)
+				})
+				mockRepositoryService.EXPECT().Update(gomock.Any(), model.NewGithubRepository(model.Identifier(1), repositoryName).AddVersion(model.DefaultPattern, "1.1.0")).Return(nil)
+
+				if intention == "success" {
+					mockReleaseService.EXPECT().Record(gomock.Any(), gomock.Len(1)).Return(nil)
+				} else {
+					mockReleaseService.EXPECT().Record(gomock.Any(), gomock.Any()).Return(errors.New("failed"))
+				}
+			case "yanked error":
+				mockReleaseService.EXPECT().ListYanked(gomock.Any()).Return(nil, errors.New("failed"))
+			case "known yanked", "new yanked":
+				mockReleaseService.EXPECT().ListYanked(gomock.Any()).Return([]model.Release{
+					model.NewRelease(pypiRepository, "", safePEP440("0.9.0").Yank()),
+				}, nil)
+				mockRepositoryService.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any(), kinds...).Return([]model.Repository{pypiRepository}, nil)
+
+				if intention == "known yanked" {
+					mockRepositoryService.EXPECT().Versions(gomock.Any(), gomock.Any()).Return(map[string]semver.Version{
+						model.DefaultPattern: safePEP440(repositoryVersion),
+					}, []string{"0.9.0"}, nil)
+				} else {
+					mockRepositoryService.EXPECT().Versions(gomock.Any(), gomock.Any()).Return(map[string]semver.Version{
+						model.DefaultPattern: safePEP440(repositoryVersion),
+					}, []string{"0.9.0", "0.9.1"}, nil)
+
+					mockReleaseService.EXPECT().DoAtomic(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, action func(context.Context) error) error {
+						return action(ctx)
+					})
+					mockReleaseService.EXPECT().Record(gomock.Any(), gomock.Len(1)).Return(nil)
+				}
+			}
+
+			got, gotDetected, gotErr := instance.check(context.TODO(), !testCase.args.preview, testCase.args.kinds...)
+
+			failed := false
+
+			if len(gotDetected) != testCase.wantDetected {
+				failed = true
+			} else if testCase.wantErr == nil && gotErr != nil {
+				failed = true
+			} else if testCase.wantErr != nil && gotErr == nil {
+				failed = true
+			} else if testCase.wantErr != nil && !strings.Contains(gotErr.Error(), testCase.wantErr.Error()) {
+				failed = true
+			} else if !reflect.DeepEqual(got, testCase.want) {
+				failed = true
+			}
+
+			if failed {
+				t.Errorf("check() = (%+v, %d, `%s`), want (%+v, %d, `%s`)", got, len(gotDetected), gotErr, testCase.want, testCase.wantDetected, testCase.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetNewRepositoryReleases(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		repo model.Repository
+	}
+
+	cases := map[string]struct {
+		instance Service
+		args     args
+		want     []model.Release
+	}{
+		"empty": {
+			Service{},
+			args{
+				repo: model.NewGithubRepository(model.Identifier(0), ""),
+			},
+			nil,
+		},
+		"no new": {
+			Service{},
+			args{
+				repo: model.NewGithubRepository(model.Identifier(1), repositoryName).AddVersion(model.DefaultPattern, repositoryVersion),
+			},
+			nil,
+		},
+		"invalid version": {
+			Service{},
+			args{
+				repo: model.NewGithubRepository(model.Identifier(1), repositoryName).AddVersion(model.DefaultPattern, "abcde"),
+			},
+			nil,
+		},
+		"not greater": {
+			Service{},
+			args{
+				repo: model.NewGithubRepository(model.Identifier(1), repositoryName).AddVersion(model.DefaultPattern, "1.1.0"),
+			},
+			nil,
+		},
+		"greater": {
+			Service{},
+			args{
+				repo: model.NewGithubRepository(model.Identifier(1), repositoryName).AddVersion(model.DefaultPattern, repositoryVersion),
+			},
+			[]model.Release{
+				model.NewRelease(model.NewGithubRepository(model.Identifier(1), repositoryName).AddVersion(model.DefaultPattern, repositoryVersion), model.DefaultPattern, safeParse("1.1.0")),
+			},
+		},
+		"detail error": {
+			Service{},
+			args{
+				repo: model.NewGithubRepository(model.Identifier(1), repositoryName).AddVersion(model.DefaultPattern, repositoryVersion),
+			},
+			[]model.Release{
+				model.NewRelease(model.NewGithubRepository(model.Identifier(1), repositoryName).AddVersion(model.DefaultPattern, repositoryVersion), model.DefaultPattern, safeParse("1.1.0")),
+			},
+		},
+		"yanked": {
+			Service{},
+			args{
+				repo: model.NewRepository(model.Identifier(1), model.Pypi, "requests", "").AddVersion(model.DefaultPattern, repositoryVersion),
+			},
+			[]model.Release{
+				model.NewRelease(model.NewRepository(model.Identifier(1), model.Pypi, "requests", "").AddVersion(model.DefaultPattern, repositoryVersion), "", safePEP440("0.9.0").Yank()),
+			},
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			mockRepositoryService := mocks.NewRepositoryService(ctrl)
+
+			testCase.instance.repository = mockRepositoryService
+
+			switch intention {
+			case "empty":
+				mockRepositoryService.EXPECT().Versions(gomock.Any(), gomock.Any()).Return(nil, nil, nil)
+			case "no new":
+				mockRepositoryService.EXPECT().Versions(gomock.Any(), gomock.Any()).Return(map[string]semver.Version{
+					model.DefaultPattern: safeParse(repositoryVersion),
+				}, nil, nil)
+			case "invalid version":
+				mockRepositoryService.EXPECT().Versions(gomock.Any(), gomock.Any()).Return(map[string]semver.Version{
+					model.DefaultPattern: safeParse(repositoryVersion),
+				}, nil, nil)
+			case "not greater":
+				mockRepositoryService.EXPECT().Versions(gomock.Any(), gomock.Any()).Return(map[string]semver.Version{
+					model.DefaultPattern: safeParse(repositoryVersion),
+				}, nil, nil)
+			case "greater":
+				mockRepositoryService.EXPECT().Versions(gomock.Any(), gomock.Any()).Return(map[string]semver.Version{
+					model.DefaultPattern: safeParse("1.1.0"),
+				}, nil, nil)
+				mockRepositoryService.EXPECT().ReleaseDetail(gomock.Any(), gomock.Any(), "1.1.0").Return(model.ReleaseDetail{}, nil)
+			case "detail error":
+				mockRepositoryService.EXPECT().Versions(gomock.Any(), gomock.Any()).Return(map[string]semver.Version{
+					model.DefaultPattern: safeParse("1.1.0"),
+				}, nil, nil)
+				mockRepositoryService.EXPECT().ReleaseDetail(gomock.Any(), gomock.Any(), "1.1.0").Return(model.ReleaseDetail{}, errors.New("failed"))
+			case "yanked":
+				mockRepositoryService.EXPECT().Versions(gomock.Any(), gomock.Any()).Return(map[string]semver.Version{
+					model.DefaultPattern: safePEP440(repositoryVersion),
+				}, []string{"0.9.0", "not a version"}, nil)
+			}
+
+			if got, _ := testCase.instance.getNewRepositoryReleases(context.TODO(), testCase.args.repo); !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("getNewRepositoryReleases() = %+v, want %+v", got, testCase.want)
+			}
+		})
+	}
+}
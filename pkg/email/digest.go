@@ -0,0 +1,146 @@
+package email
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/ViBiOh/ketchup/pkg/link"
+	"github.com/ViBiOh/ketchup/pkg/model"
+)
+
+const excerptLength = 280
+
+// digestRelease is a release as given to the mailer template, with what's needed to act on it from the email
+type digestRelease struct {
+	model.Release
+	Latest         string `json:"latest"`
+	Change         string `json:"change,omitempty"`
+	CompareURL     string `json:"compare_url,omitempty"`
+	Excerpt        string `json:"excerpt,omitempty"`
+	UpdateURL      string `json:"update_url,omitempty"`
+	SnoozeURL      string `json:"snooze_url,omitempty"`
+	UnsubscribeURL string `json:"unsubscribe_url,omitempty"`
+}
+
+func (s Service) digestReleases(channel model.Channel, releases []model.Release, now time.Time) []digestRelease {
+	output := make([]digestRelease, len(releases))
+
+	for index, release := range releases {
+		item := digestRelease{
+			Release: release,
+			Latest:  release.Latest(),
+			Change:  release.Change(),
+			Excerpt: excerpt(release.Notes),
+		}
+
+		if len(release.Current) != 0 && release.Current != item.Latest && !release.Version.IsYanked() {
+			item.CompareURL = release.Repository.CompareURL(release.Current, release.Pattern)
+
+			if release.Updated != 2 {
+				item.UpdateURL = s.link.URL(link.Update, channel.User.ID, release.Repository.ID, release.Pattern, item.Latest, now)
+			}
+		}
+
+		if !release.Version.IsYanked() {
+			item.SnoozeURL = s.link.URL(link.Snooze, channel.User.ID, release.Repository.ID, release.Pattern, item.Latest, now)
+			item.UnsubscribeURL = s.link.URL(link.Unsubscribe, channel.User.ID, release.Repository.ID, release.Pattern, item.Latest, now)
+		}
+
+		output[index] = item
+	}
+
+	return output
+}
+
+// excerpt gives the beginning of the release notes, cut on a word
+func excerpt(notes string) string {
+	notes = strings.TrimSpace(notes)
+	if utf8.RuneCountInString(notes) <= excerptLength {
+		return notes
+	}
+
+	notes = string([]rune(notes)[:excerptLength])
+	if index := strings.LastIndexAny(notes, " \n\t"); index > 0 {
+		notes = notes[:index]
+	}
+
+	return strings.TrimSpace(notes) + "…"
+}
+
+// plainText renders the digest for the clients that don't display HTML
+func plainText(releases, yanked []digestRelease) string {
+	var builder strings.Builder
+
+	var kind string
+	for _, release := range releases {
+		if name := release.Repository.Kind.String(); name != kind {
+			if len(kind) != 0 {
+				builder.WriteString("\n")
+			}
+
+			kind = name
+			fmt.Fprintf(&builder, "# %s\n\n", kind)
+		}
+
+		writeRelease(&builder, release)
+	}
+
+	if len(yanked) != 0 {
+		if builder.Len() != 0 {
+			builder.WriteString("\n")
+		}
+
+		builder.WriteString("# Yanked versions\n\n")
+
+		for _, release := range yanked {
+			fmt.Fprintf(&builder, "- %s %s has been yanked, you are using it\n", release.Repository, release.Version.Name)
+		}
+	}
+
+	return builder.String()
+}
+
+func writeRelease(builder *strings.Builder, release digestRelease) {
+	fmt.Fprintf(builder, "- %s [%s]: ", release.Repository, release.Pattern)
+
+	if len(release.Current) == 0 || release.Current == release.Latest {
+		fmt.Fprintf(builder, "%s", release.Latest)
+	} else {
+		fmt.Fprintf(builder, "%s -> %s", release.Current, release.Latest)
+	}
+
+	if len(release.Change) != 0 {
+		fmt.Fprintf(builder, " (%s)", strings.ToLower(release.Change))
+	}
+
+	switch release.Updated {
+	case 1:
+		builder.WriteString(", auto-update failed")
+	case 2:
+		builder.WriteString(", auto-updated")
+	}
+
+	fmt.Fprintf(builder, "\n  %s\n", release.Repository.VersionURL(release.Latest))
+
+	if len(release.CompareURL) != 0 && release.CompareURL != release.Repository.VersionURL(release.Latest) {
+		fmt.Fprintf(builder, "  Compare: %s\n", release.CompareURL)
+	}
+
+	if len(release.Excerpt) != 0 {
+		fmt.Fprintf(builder, "  %s\n", strings.ReplaceAll(release.Excerpt, "\n", "\n  "))
+	}
+
+	if len(release.UpdateURL) != 0 {
+		fmt.Fprintf(builder, "  Mark as updated: %s\n", release.UpdateURL)
+	}
+
+	if len(release.SnoozeURL) != 0 {
+		fmt.Fprintf(builder, "  Snooze for a week: %s\n", release.SnoozeURL)
+	}
+
+	if len(release.UnsubscribeURL) != 0 {
+		fmt.Fprintf(builder, "  Stop notifying: %s\n", release.UnsubscribeURL)
+	}
+}
@@ -0,0 +1,99 @@
+package email
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ViBiOh/ketchup/pkg/link"
+	"github.com/ViBiOh/ketchup/pkg/model"
+	"github.com/ViBiOh/ketchup/pkg/semver"
+)
+
+func TestExcerpt(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		notes string
+		want  string
+	}{
+		"empty": {
+			"",
+			"",
+		},
+		"short": {
+			"  Bug fixes\n",
+			"Bug fixes",
+		},
+		"long": {
+			strings.Repeat("fix ", 100),
+			strings.TrimSpace(strings.Repeat("fix ", 70)) + "…",
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			if got := excerpt(testCase.notes); got != testCase.want {
+				t.Errorf("excerpt() = `%s`, want `%s`", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestPlainText(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)
+	repository := model.NewGithubRepository(model.Identifier(1), "vibioh/ketchup").AddVersion(model.DefaultPattern, "1.2.0")
+	channel := model.NewEmailChannel(model.User{ID: 3, Email: "nobody@localhost"})
+
+	version, _ := semver.Parse("1.2.0", "")
+	release := model.NewRelease(repository, model.DefaultPattern, version).SetCurrent("1.1.0").SetDetail(model.ReleaseDetail{Notes: "Bug fixes"})
+
+	cases := map[string]struct {
+		link     link.Service
+		releases []model.Release
+		want     string
+	}{
+		"empty": {
+			link.New(&link.Config{}),
+			nil,
+			"",
+		},
+		"without link": {
+			link.New(&link.Config{}),
+			[]model.Release{release},
+			"# Github\n\n- vibioh/ketchup [stable]: 1.1.0 -> 1.2.0 (minor)\n  https://github.com/vibioh/ketchup/releases/tag/1.2.0\n  Compare: https://github.com/vibioh/ketchup/compare/1.1.0...1.2.0\n  Bug fixes\n",
+		},
+		"with link": {
+			link.New(&link.Config{URL: "https://ketchup.localhost", Secret: "secret", Validity: time.Hour}),
+			[]model.Release{release},
+			"  Mark as updated: https://ketchup.localhost/action?action=update&expires=",
+		},
+		"snooze link": {
+			link.New(&link.Config{URL: "https://ketchup.localhost", Secret: "secret", Validity: time.Hour}),
+			[]model.Release{release},
+			"  Snooze for a week: https://ketchup.localhost/action?action=snooze&expires=",
+		},
+		"auto-updated": {
+			link.New(&link.Config{URL: "https://ketchup.localhost", Secret: "secret", Validity: time.Hour}),
+			[]model.Release{release.SetUpdated(2)},
+			"# Github\n\n- vibioh/ketchup [stable]: 1.1.0 -> 1.2.0 (minor), auto-updated\n  https://github.com/vibioh/ketchup/releases/tag/1.2.0\n  Compare: https://github.com/vibioh/ketchup/compare/1.1.0...1.2.0\n  Bug fixes\n",
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			instance := Service{link: testCase.link}
+			releases := instance.digestReleases(channel, testCase.releases, now)
+
+			if got := plainText(releases, nil); !strings.Contains(got, testCase.want) || (len(testCase.want) == 0 && len(got) != 0) {
+				t.Errorf("plainText() = `%s`, want `%s`", got, testCase.want)
+			}
+		})
+	}
+}
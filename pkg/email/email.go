@@ -0,0 +1,129 @@
+package email
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ViBiOh/flags"
+	"github.com/ViBiOh/ketchup/pkg/link"
+	"github.com/ViBiOh/ketchup/pkg/model"
+	mailerModel "github.com/ViBiOh/mailer/pkg/model"
+)
+
+const template = "ketchup"
+
+type Service struct {
+	mailer model.Mailer
+	clock  func() time.Time
+	link   link.Service
+	from   string
+	name   string
+}
+
+type Config struct {
+	From string
+	Name string
+}
+
+func Flags(fs *flag.FlagSet, prefix string) *Config {
+	var config Config
+
+	flags.New("From", "Sender address").Prefix(prefix).DocPrefix("email").StringVar(fs, &config.From, "ketchup@vibioh.fr", nil)
+	flags.New("Name", "Sender name").Prefix(prefix).DocPrefix("email").StringVar(fs, &config.Name, "Ketchup", nil)
+
+	return &config
+}
+
+func New(config *Config, mailer model.Mailer, linkService link.Service) Service {
+	return Service{
+		mailer: mailer,
+		clock:  time.Now,
+		link:   linkService,
+		from:   config.From,
+		name:   config.Name,
+	}
+}
+
+func (s Service) Send(ctx context.Context, channel model.Channel, releases []model.Release) error {
+	to := recipient(channel)
+
+	slog.LogAttrs(ctx, slog.LevelInfo, "Sending email", slog.String("to", to), slog.Int("count", len(releases)))
+
+	mr := mailerModel.NewMailRequest().
+		Template(template).
+		From(s.from).
+		As(s.name).
+		To(to).
+		Data(s.payload(channel, releases)).
+		WithSubject(Subject(releases))
+
+	if err := s.mailer.Send(ctx, mr); err != nil {
+		return fmt.Errorf("send email to %s: %w", to, err)
+	}
+
+	return nil
+}
+
+// Preview renders the plain text email, and the payload of the mailer template as a fixture for rendering its HTML with the mailer
+func (s Service) Preview(channel model.Channel, releases []model.Release) ([]model.Preview, error) {
+	payload := s.payload(channel, releases)
+
+	fixture, err := json.MarshalIndent(payload, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal payload: %w", err)
+	}
+
+	text := fmt.Sprintf("From: %s <%s>\nTo: %s\nSubject: %s\n\n%s", s.name, s.from, recipient(channel), Subject(releases), payload["text"])
+
+	return []model.Preview{
+		{Format: "txt", Content: []byte(text)},
+		{Format: "json", Content: fixture},
+	}, nil
+}
+
+func recipient(channel model.Channel) string {
+	if len(channel.URL) != 0 {
+		return channel.URL
+	}
+
+	return channel.User.Email
+}
+
+func (s Service) payload(channel model.Channel, releases []model.Release) map[string]any {
+	newReleases, yankedReleases := model.SplitYankedReleases(releases)
+
+	now := s.clock()
+	digestNewReleases := s.digestReleases(channel, newReleases, now)
+	digestYankedReleases := s.digestReleases(channel, yankedReleases, now)
+
+	return map[string]any{
+		"releases": digestNewReleases,
+		"yanked":   digestYankedReleases,
+		"text":     plainText(digestNewReleases, digestYankedReleases),
+	}
+}
+
+// Subject names the digest after the frequencies of the ketchups it contains
+func Subject(releases []model.Release) string {
+	var names []string
+
+	for _, frequency := range []model.KetchupFrequency{model.Immediate, model.Daily, model.Weekly, model.Monthly} {
+		if slices.ContainsFunc(releases, func(release model.Release) bool {
+			return release.Frequency == frequency
+		}) {
+			names = append(names, frequency.String())
+		}
+	}
+
+	if len(names) == 0 {
+		names = append(names, model.Daily.String())
+	}
+
+	return fmt.Sprintf("Ketchup - %s notification", strings.Join(names, " & "))
+}
@@ -0,0 +1,223 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"strings"
+	"testing"
+
+	"github.com/ViBiOh/ketchup/pkg/link"
+	"github.com/ViBiOh/ketchup/pkg/mocks"
+	"github.com/ViBiOh/ketchup/pkg/model"
+	"github.com/ViBiOh/ketchup/pkg/semver"
+	mailerModel "github.com/ViBiOh/mailer/pkg/model"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFlags(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		want string
+	}{
+		"simple": {
+			"Usage of simple:\n  -from string\n    \t[email] Sender address ${SIMPLE_FROM} (default \"ketchup@vibioh.fr\")\n  -name string\n    \t[email] Sender name ${SIMPLE_NAME} (default \"Ketchup\")\n",
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			fs := flag.NewFlagSet(intention, flag.ContinueOnError)
+			Flags(fs, "")
+
+			var writer strings.Builder
+			fs.SetOutput(&writer)
+			fs.Usage()
+
+			result := writer.String()
+
+			if result != testCase.want {
+				t.Errorf("Flags() = `%s`, want `%s`", result, testCase.want)
+			}
+		})
+	}
+}
+
+func TestSubject(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		releases []model.Release
+	}
+
+	cases := map[string]struct {
+		args args
+		want string
+	}{
+		"empty": {
+			args{},
+			"Ketchup - Daily notification",
+		},
+		"weekly": {
+			args{
+				releases: []model.Release{{Frequency: model.Weekly}},
+			},
+			"Ketchup - Weekly notification",
+		},
+		"both": {
+			args{
+				releases: []model.Release{{Frequency: model.Weekly}, {Frequency: model.Daily}},
+			},
+			"Ketchup - Daily & Weekly notification",
+		},
+		"immediate": {
+			args{
+				releases: []model.Release{{Frequency: model.Immediate}},
+			},
+			"Ketchup - Immediate notification",
+		},
+		"all": {
+			args{
+				releases: []model.Release{{Frequency: model.Monthly}, {Frequency: model.Weekly}, {Frequency: model.Daily}, {Frequency: model.Immediate}},
+			},
+			"Ketchup - Immediate & Daily & Weekly & Monthly notification",
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			if got := Subject(testCase.args.releases); got != testCase.want {
+				t.Errorf("Subject() = `%s`, want `%s`", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestSend(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		channel model.Channel
+	}
+
+	cases := map[string]struct {
+		args    args
+		wantTo  string
+		wantErr error
+	}{
+		"user email": {
+			args{
+				channel: model.NewEmailChannel(model.User{Email: "nobody@localhost"}),
+			},
+			"nobody@localhost",
+			nil,
+		},
+		"channel address": {
+			args{
+				channel: model.Channel{Kind: model.Email, URL: "team@localhost", User: model.User{Email: "nobody@localhost"}},
+			},
+			"team@localhost",
+			nil,
+		},
+		"error": {
+			args{
+				channel: model.NewEmailChannel(model.User{Email: "nobody@localhost"}),
+			},
+			"nobody@localhost",
+			errors.New("send email to nobody@localhost: failed"),
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			mockMailer := mocks.NewMailer(ctrl)
+
+			instance := New(&Config{From: "ketchup@localhost", Name: "Ketchup"}, mockMailer, link.New(&link.Config{}))
+
+			var sendErr error
+			if testCase.wantErr != nil {
+				sendErr = errors.New("failed")
+			}
+
+			var gotTo []string
+			mockMailer.EXPECT().Send(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, mr mailerModel.MailRequest) error {
+				gotTo = mr.Recipients
+
+				return sendErr
+			})
+
+			gotErr := instance.Send(context.TODO(), testCase.args.channel, nil)
+
+			failed := false
+
+			if testCase.wantErr == nil && gotErr != nil {
+				failed = true
+			} else if testCase.wantErr != nil && gotErr == nil {
+				failed = true
+			} else if testCase.wantErr != nil && gotErr.Error() != testCase.wantErr.Error() {
+				failed = true
+			} else if len(gotTo) != 1 || gotTo[0] != testCase.wantTo {
+				failed = true
+			}
+
+			if failed {
+				t.Errorf("Send() = (%v, `%s`), want (`%s`, `%s`)", gotTo, gotErr, testCase.wantTo, testCase.wantErr)
+			}
+		})
+	}
+}
+
+func TestPreview(t *testing.T) {
+	t.Parallel()
+
+	repository := model.NewGithubRepository(model.Identifier(1), "vibioh/ketchup").AddVersion(model.DefaultPattern, "1.2.0")
+	version, _ := semver.Parse("1.2.0", "")
+	release := model.NewRelease(repository, model.DefaultPattern, version).SetCurrent("1.1.0").SetFrequency(model.Daily)
+
+	cases := map[string]struct {
+		channel  model.Channel
+		wantText string
+	}{
+		"user email": {
+			model.NewEmailChannel(model.User{Email: "nobody@localhost"}),
+			"From: Ketchup <ketchup@localhost>\nTo: nobody@localhost\nSubject: Ketchup - Daily notification\n\n# Github\n\n- vibioh/ketchup [stable]: 1.1.0 -> 1.2.0 (minor)\n",
+		},
+		"channel address": {
+			model.Channel{Kind: model.Email, URL: "team@localhost", User: model.User{Email: "nobody@localhost"}},
+			"From: Ketchup <ketchup@localhost>\nTo: team@localhost\nSubject: Ketchup - Daily notification\n\n# Github\n\n- vibioh/ketchup [stable]: 1.1.0 -> 1.2.0 (minor)\n",
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			instance := New(&Config{From: "ketchup@localhost", Name: "Ketchup"}, nil, link.New(&link.Config{}))
+
+			got, gotErr := instance.Preview(testCase.channel, []model.Release{release})
+
+			failed := false
+
+			if gotErr != nil || len(got) != 2 {
+				failed = true
+			} else if got[0].Format != "txt" || !strings.HasPrefix(string(got[0].Content), testCase.wantText) {
+				failed = true
+			} else if got[1].Format != "json" || !strings.Contains(string(got[1].Content), `"latest": "1.2.0"`) {
+				failed = true
+			}
+
+			if failed {
+				t.Errorf("Preview() = (%+v, `%s`), want `%s`", got, gotErr, testCase.wantText)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package ketchup
+
+import (
+	"net/http"
+	"time"
+
+	httpModel "github.com/ViBiOh/httputils/v4/pkg/model"
+	"github.com/ViBiOh/httputils/v4/pkg/renderer"
+	"github.com/ViBiOh/ketchup/pkg/link"
+	"github.com/ViBiOh/ketchup/pkg/model"
+)
+
+// ActionTemplateFunc asks for confirmation of a one-click link, for the link not to be followed by mail scanners
+func (s Service) ActionTemplateFunc(_ http.ResponseWriter, r *http.Request) (renderer.Page, error) {
+	values := r.URL.Query()
+
+	item, err := s.link.Parse(values, time.Now())
+	if err != nil {
+		return renderer.NewPage("", http.StatusBadRequest, nil), httpModel.WrapInvalid(err)
+	}
+
+	return renderer.NewPage("action", http.StatusOK, map[string]any{
+		"Root":   "/",
+		"Link":   item,
+		"Values": values,
+	}), nil
+}
+
+func (s Service) Action() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			s.renderer.Error(w, r, nil, httpModel.WrapInvalid(err))
+			return
+		}
+
+		now := time.Now()
+
+		item, err := s.link.Parse(r.PostForm, now)
+		if err != nil {
+			s.renderer.Error(w, r, nil, httpModel.WrapInvalid(err))
+			return
+		}
+
+		ctx := r.Context()
+		var message renderer.Message
+
+		switch item.Action {
+		case link.Snooze:
+			until := now.Add(link.SnoozeDuration)
+			err = s.ketchup.Snooze(ctx, item.UserID, item.RepositoryID, item.Pattern, until)
+			message = renderer.NewSuccessMessage("Snoozed until %s with success!", until.Format(time.DateOnly))
+		case link.Unsubscribe:
+			err = s.ketchup.UpdateFrequency(ctx, item.UserID, item.RepositoryID, item.Pattern, model.None)
+			message = renderer.NewSuccessMessage("Notifications stopped with success!")
+		case link.Update:
+			err = s.ketchup.UpdateVersion(ctx, item.UserID, item.RepositoryID, item.Pattern, item.Version)
+			message = renderer.NewSuccessMessage("Updated to %s with success!", item.Version)
+		default:
+			s.renderer.Error(w, r, nil, httpModel.WrapInvalid(link.ErrUnknownAction))
+			return
+		}
+
+		if err != nil {
+			s.renderer.Error(w, r, nil, toHttpError(err))
+			return
+		}
+
+		s.renderer.Redirect(w, r, "/", message)
+	})
+}
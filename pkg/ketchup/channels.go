@@ -0,0 +1,88 @@
+package ketchup
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	httpModel "github.com/ViBiOh/httputils/v4/pkg/model"
+	"github.com/ViBiOh/httputils/v4/pkg/renderer"
+	"github.com/ViBiOh/ketchup/pkg/model"
+)
+
+func (s Service) Channels() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			s.renderer.Error(w, r, nil, httpModel.WrapMethodNotAllowed(fmt.Errorf("invalid method %s", r.Method)))
+			return
+		}
+
+		if err := r.ParseForm(); err != nil {
+			s.renderer.Error(w, r, nil, httpModel.WrapInvalid(err))
+			return
+		}
+
+		method := strings.ToUpper(r.FormValue("method"))
+
+		switch method {
+		case http.MethodPost:
+			s.handleChannelCreate(w, r)
+		case http.MethodDelete:
+			s.handleChannelDelete(w, r)
+		default:
+			s.renderer.Error(w, r, nil, httpModel.WrapInvalid(fmt.Errorf("invalid method %s", method)))
+		}
+	})
+}
+
+func (s Service) handleChannelCreate(w http.ResponseWriter, r *http.Request) {
+	rawChannelKind := r.FormValue("kind")
+	channelKind, err := model.ParseChannelKind(rawChannelKind)
+	if err != nil {
+		s.renderer.Error(w, r, nil, httpModel.WrapInvalid(fmt.Errorf("parse kind `%s`: %w", rawChannelKind, err)))
+		return
+	}
+
+	var frequencies []model.KetchupFrequency
+
+	for _, rawKetchupFrequency := range r.Form["frequencies"] {
+		ketchupFrequency, err := model.ParseKetchupFrequency(rawKetchupFrequency)
+		if err != nil {
+			s.renderer.Error(w, r, nil, httpModel.WrapInvalid(fmt.Errorf("parse frequency `%s`: %w", rawKetchupFrequency, err)))
+			return
+		}
+
+		frequencies = append(frequencies, ketchupFrequency)
+	}
+
+	item := model.Channel{
+		Kind:        channelKind,
+		URL:         strings.TrimSpace(r.FormValue("url")),
+		Secret:      r.FormValue("secret"),
+		Frequencies: frequencies,
+	}
+
+	created, err := s.channel.Create(r.Context(), item)
+	if err != nil {
+		s.renderer.Error(w, r, nil, err)
+		return
+	}
+
+	s.renderer.Redirect(w, r, fmt.Sprintf("%s/", appPath), renderer.NewSuccessMessage("%s channel created with success!", created.Kind))
+}
+
+func (s Service) handleChannelDelete(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+	if err != nil {
+		s.renderer.Error(w, r, nil, httpModel.WrapInvalid(err))
+		return
+	}
+
+	if err := s.channel.Delete(r.Context(), model.Channel{ID: model.Identifier(id)}); err != nil {
+		s.renderer.Error(w, r, nil, err)
+		return
+	}
+
+	s.renderer.Redirect(w, r, fmt.Sprintf("%s/", appPath), renderer.NewSuccessMessage("Channel deleted with success!"))
+}
@@ -0,0 +1,155 @@
+package ketchup
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+
+	httpModel "github.com/ViBiOh/httputils/v4/pkg/model"
+	"github.com/ViBiOh/httputils/v4/pkg/renderer"
+	"github.com/ViBiOh/ketchup/pkg/model"
+)
+
+const feedSize = uint(50)
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+}
+
+type atomContent struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+type atomEntry struct {
+	Content   *atomContent `xml:"content,omitempty"`
+	Title     string       `xml:"title"`
+	ID        string       `xml:"id"`
+	Updated   string       `xml:"updated"`
+	Published string       `xml:"published,omitempty"`
+	Link      atomLink     `xml:"link"`
+	Summary   string       `xml:"summary"`
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	Title   string      `xml:"title"`
+	ID      string      `xml:"id"`
+	Updated string      `xml:"updated"`
+	Author  string      `xml:"author>name"`
+	Links   []atomLink  `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+func (s Service) Feed() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		user, err := s.user.GetByFeedToken(ctx, r.PathValue("token"))
+		if err != nil {
+			if errors.Is(err, httpModel.ErrNotFound) {
+				http.Error(w, "feed not found", http.StatusNotFound)
+				return
+			}
+
+			slog.LogAttrs(ctx, slog.LevelError, "get feed user", slog.Any("error", err))
+			http.Error(w, "unable to get feed", http.StatusInternalServerError)
+			return
+		}
+
+		releases, err := s.release.ListForUser(ctx, user, feedSize)
+		if err != nil {
+			slog.LogAttrs(ctx, slog.LevelError, "list feed releases", slog.String("user", user.String()), slog.Any("error", err))
+			http.Error(w, "unable to get feed", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/atom+xml; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+
+		if _, err := w.Write([]byte(xml.Header)); err != nil {
+			slog.LogAttrs(ctx, slog.LevelError, "write feed header", slog.Any("error", err))
+			return
+		}
+
+		if err := xml.NewEncoder(w).Encode(atomFeedOf(user, selfURL(r), releases)); err != nil {
+			slog.LogAttrs(ctx, slog.LevelError, "encode feed", slog.Any("error", err))
+		}
+	})
+}
+
+func (s Service) FeedToken() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			s.renderer.Error(w, r, nil, httpModel.WrapMethodNotAllowed(fmt.Errorf("invalid method %s", r.Method)))
+			return
+		}
+
+		if _, err := s.user.RegenerateFeedToken(r.Context()); err != nil {
+			s.renderer.Error(w, r, nil, err)
+			return
+		}
+
+		s.renderer.Redirect(w, r, fmt.Sprintf("%s/", appPath), renderer.NewSuccessMessage("Feed URL generated with success!"))
+	})
+}
+
+func selfURL(r *http.Request) string {
+	scheme := "https"
+	if r.TLS == nil && r.Header.Get("X-Forwarded-Proto") != "https" {
+		scheme = "http"
+	}
+
+	return fmt.Sprintf("%s://%s%s", scheme, r.Host, r.URL.Path)
+}
+
+func atomFeedOf(user model.User, self string, releases []model.Release) atomFeed {
+	feed := atomFeed{
+		Title:   "Ketchup releases",
+		ID:      fmt.Sprintf("urn:ketchup:feed:%d", user.ID),
+		Updated: time.Now().UTC().Format(time.RFC3339),
+		Author:  "Ketchup",
+		Links:   []atomLink{{Href: self, Rel: "self"}},
+		Entries: make([]atomEntry, len(releases)),
+	}
+
+	if len(releases) != 0 {
+		feed.Updated = releases[0].DetectedAt.UTC().Format(time.RFC3339)
+	}
+
+	for index, release := range releases {
+		feed.Entries[index] = atomEntryOf(release)
+	}
+
+	return feed
+}
+
+func atomEntryOf(release model.Release) atomEntry {
+	summary := fmt.Sprintf("Version %s released for pattern %s.", release.Version.Name, release.Pattern)
+	if len(release.Current) != 0 && release.Current != release.Version.Name {
+		summary += fmt.Sprintf(" You are using %s.", release.Current)
+	}
+
+	entry := atomEntry{
+		Title:   fmt.Sprintf("%s %s", release.Repository, release.Version.Name),
+		ID:      fmt.Sprintf("urn:ketchup:release:%d:%s:%s", release.Repository.ID, url.PathEscape(release.Pattern), url.PathEscape(release.Version.Name)),
+		Updated: release.DetectedAt.UTC().Format(time.RFC3339),
+		Link:    atomLink{Href: release.URL, Rel: "alternate"},
+		Summary: summary,
+	}
+
+	if !release.PublishedAt.IsZero() {
+		entry.Published = release.PublishedAt.UTC().Format(time.RFC3339)
+	}
+
+	if len(release.Notes) != 0 {
+		entry.Content = &atomContent{Type: "text", Value: release.Notes}
+	}
+
+	return entry
+}
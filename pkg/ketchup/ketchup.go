@@ -10,8 +10,12 @@ import (
 	"github.com/ViBiOh/httputils/v4/pkg/redis"
 	"github.com/ViBiOh/httputils/v4/pkg/renderer"
 	"github.com/ViBiOh/ketchup/pkg/cap"
+	"github.com/ViBiOh/ketchup/pkg/link"
 	"github.com/ViBiOh/ketchup/pkg/model"
+	"github.com/ViBiOh/ketchup/pkg/service/channel"
 	"github.com/ViBiOh/ketchup/pkg/service/ketchup"
+	"github.com/ViBiOh/ketchup/pkg/service/release"
+	"github.com/ViBiOh/ketchup/pkg/service/report"
 	"github.com/ViBiOh/ketchup/pkg/service/repository"
 	"github.com/ViBiOh/ketchup/pkg/service/user"
 	"go.opentelemetry.io/otel/trace"
@@ -26,10 +30,17 @@ var FuncMap = template.FuncMap{
 			return "bell-slash"
 		case model.Weekly:
 			return "calendar"
+		case model.Monthly:
+			return "calendar-alt"
+		case model.Immediate:
+			return "bolt"
 		default:
 			return "clock"
 		}
 	},
+	"isSnoozed": func(ketchup model.Ketchup) bool {
+		return time.Now().Before(ketchup.SnoozedUntil)
+	},
 }
 
 type LogoutService interface {
@@ -40,19 +51,27 @@ type Service struct {
 	repository repository.Service
 	user       user.Service
 	ketchup    ketchup.Service
+	channel    channel.Service
+	release    release.Service
+	report     report.Service
 	redis      redis.Client
 	logout     LogoutService
 	cache      *cache.Cache[model.User, []model.Repository]
 	renderer   *renderer.Service
 	cap        cap.Service
+	link       link.Service
 }
 
-func New(ctx context.Context, renderer *renderer.Service, ketchup ketchup.Service, user user.Service, repository repository.Service, cap cap.Service, logout LogoutService, redis redis.Client, traceProvider trace.TracerProvider) Service {
+func New(ctx context.Context, renderer *renderer.Service, ketchup ketchup.Service, channel channel.Service, release release.Service, user user.Service, repository repository.Service, cap cap.Service, link link.Service, report report.Service, logout LogoutService, redis redis.Client, traceProvider trace.TracerProvider) Service {
 	service := Service{
 		renderer:   renderer,
 		cap:        cap,
+		link:       link,
+		report:     report,
 		logout:     logout,
 		ketchup:    ketchup,
+		channel:    channel,
+		release:    release,
 		user:       user,
 		repository: repository,
 		redis:      redis,
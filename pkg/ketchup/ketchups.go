@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	httpModel "github.com/ViBiOh/httputils/v4/pkg/model"
 	"github.com/ViBiOh/httputils/v4/pkg/renderer"
@@ -35,6 +36,10 @@ func (s Service) Ketchups() http.Handler {
 			s.handleUpdate(w, r)
 		case http.MethodDelete:
 			s.handleDelete(w, r)
+		case "SNOOZE":
+			s.handleSnooze(w, r)
+		case "SKIP":
+			s.handleSkip(w, r)
 		default:
 			s.renderer.Error(w, r, nil, httpModel.WrapInvalid(fmt.Errorf("invalid method %s", method)))
 		}
@@ -57,6 +62,19 @@ func (s Service) handleCreate(w http.ResponseWriter, r *http.Request) {
 
 	updateWhenNotify := r.FormValue("update-when-notify") == "true"
 
+	cooldown, err := parseCooldown(r.FormValue("cooldown"))
+	if err != nil {
+		s.renderer.Error(w, r, nil, httpModel.WrapInvalid(err))
+		return
+	}
+
+	rawKetchupSeverity := r.FormValue("severity")
+	ketchupSeverity, err := parseSeverity(rawKetchupSeverity)
+	if err != nil {
+		s.renderer.Error(w, r, nil, httpModel.WrapInvalid(fmt.Errorf("parse severity `%s`: %w", rawKetchupSeverity, err)))
+		return
+	}
+
 	var repository model.Repository
 	name := r.FormValue("name")
 
@@ -70,7 +88,7 @@ func (s Service) handleCreate(w http.ResponseWriter, r *http.Request) {
 	}
 
 	ctx := r.Context()
-	item := model.NewKetchup(r.FormValue("pattern"), r.FormValue("version"), ketchupFrequency, updateWhenNotify, repository).WithID()
+	item := model.NewKetchup(r.FormValue("pattern"), r.FormValue("version"), ketchupFrequency, updateWhenNotify, repository).SetCooldown(cooldown).SetSeverity(ketchupSeverity).WithID()
 
 	created, err := s.ketchup.Create(r.Context(), item)
 	if err != nil {
@@ -113,7 +131,20 @@ func (s Service) handleUpdate(w http.ResponseWriter, r *http.Request) {
 
 	updateWhenNotify := r.FormValue("update-when-notify") == "true"
 
-	item := model.NewKetchup(r.FormValue("pattern"), r.FormValue("version"), ketchupFrequency, updateWhenNotify, model.NewGithubRepository(model.Identifier(id), "")).WithID()
+	cooldown, err := parseCooldown(r.FormValue("cooldown"))
+	if err != nil {
+		s.renderer.Error(w, r, nil, httpModel.WrapInvalid(err))
+		return
+	}
+
+	rawKetchupSeverity := r.FormValue("severity")
+	ketchupSeverity, err := parseSeverity(rawKetchupSeverity)
+	if err != nil {
+		s.renderer.Error(w, r, nil, httpModel.WrapInvalid(fmt.Errorf("parse severity `%s`: %w", rawKetchupSeverity, err)))
+		return
+	}
+
+	item := model.NewKetchup(r.FormValue("pattern"), r.FormValue("version"), ketchupFrequency, updateWhenNotify, model.NewGithubRepository(model.Identifier(id), "")).SetCooldown(cooldown).SetSeverity(ketchupSeverity).WithID()
 
 	updated, err := s.ketchup.Update(r.Context(), r.FormValue("old-pattern"), item)
 	if err != nil {
@@ -146,6 +177,58 @@ func (s Service) handleDelete(w http.ResponseWriter, r *http.Request) {
 	s.renderer.Redirect(w, r, fmt.Sprintf("%s/", appPath), renderer.NewSuccessMessage("Deleted with success!"))
 }
 
+func (s Service) handleSnooze(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+	if err != nil {
+		s.renderer.Error(w, r, nil, httpModel.WrapInvalid(err))
+		return
+	}
+
+	ctx := r.Context()
+	user := model.ReadUser(ctx)
+
+	until, err := parseSnooze(r.FormValue("until"), user.Location())
+	if err != nil {
+		s.renderer.Error(w, r, nil, httpModel.WrapInvalid(err))
+		return
+	}
+
+	if err := s.ketchup.Snooze(ctx, user.ID, model.Identifier(id), r.FormValue("pattern"), until); err != nil {
+		s.renderer.Error(w, r, nil, toHttpError(err))
+		return
+	}
+
+	if until.IsZero() {
+		s.renderer.Redirect(w, r, fmt.Sprintf("%s/", appPath), renderer.NewSuccessMessage("Snooze cleared with success!"))
+		return
+	}
+
+	s.renderer.Redirect(w, r, fmt.Sprintf("%s/", appPath), renderer.NewSuccessMessage("Snoozed until %s with success!", until.Format(time.DateOnly)))
+}
+
+func (s Service) handleSkip(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+	if err != nil {
+		s.renderer.Error(w, r, nil, httpModel.WrapInvalid(err))
+		return
+	}
+
+	ctx := r.Context()
+	version := strings.TrimSpace(r.FormValue("version"))
+
+	if err := s.ketchup.SkipVersion(ctx, model.ReadUser(ctx).ID, model.Identifier(id), r.FormValue("pattern"), version); err != nil {
+		s.renderer.Error(w, r, nil, toHttpError(err))
+		return
+	}
+
+	if len(version) == 0 {
+		s.renderer.Redirect(w, r, fmt.Sprintf("%s/", appPath), renderer.NewSuccessMessage("Skipped version cleared with success!"))
+		return
+	}
+
+	s.renderer.Redirect(w, r, fmt.Sprintf("%s/", appPath), renderer.NewSuccessMessage("Skipped %s with success!", version))
+}
+
 func toHttpError(err error) error {
 	switch {
 	case errors.Is(err, semver.ErrPatternInvalid) || errors.Is(err, semver.ErrPrefixInvalid):
@@ -154,3 +237,38 @@ func toHttpError(err error) error {
 		return err
 	}
 }
+
+func parseCooldown(value string) (uint, error) {
+	if len(value) == 0 {
+		return 0, nil
+	}
+
+	cooldown, err := strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("parse cooldown `%s`: %w", value, err)
+	}
+
+	return uint(cooldown), nil
+}
+
+func parseSeverity(value string) (model.KetchupSeverity, error) {
+	if len(value) == 0 {
+		return model.SeverityAny, nil
+	}
+
+	return model.ParseKetchupSeverity(value)
+}
+
+// parseSnooze reads a day in the user's timezone, the snooze ending at its start
+func parseSnooze(value string, location *time.Location) (time.Time, error) {
+	if len(value) == 0 {
+		return time.Time{}, nil
+	}
+
+	until, err := time.ParseInLocation(time.DateOnly, value, location)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parse snooze `%s`: %w", value, err)
+	}
+
+	return until, nil
+}
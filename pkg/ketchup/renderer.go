@@ -4,6 +4,7 @@ import (
 	"context"
 	"log/slog"
 	"net/http"
+	"time"
 
 	"github.com/ViBiOh/httputils/v4/pkg/query"
 	"github.com/ViBiOh/httputils/v4/pkg/renderer"
@@ -12,6 +13,11 @@ import (
 
 const suggestThresold = uint64(5)
 
+var (
+	hours    = []uint{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23}
+	weekdays = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday, time.Sunday}
+)
+
 func (s Service) PublicTemplateFunc(_ http.ResponseWriter, r *http.Request) (renderer.Page, error) {
 	return renderer.NewPage("public", http.StatusOK, map[string]any{
 		"CapSiteURL": s.cap.SiteURL(),
@@ -31,9 +37,30 @@ func (s Service) TemplateFunc(_ http.ResponseWriter, r *http.Request) (renderer.
 		return renderer.NewPage("", http.StatusInternalServerError, nil), err
 	}
 
+	channels, err := s.channel.List(r.Context())
+	if err != nil {
+		return renderer.NewPage("", http.StatusInternalServerError, nil), err
+	}
+
+	feedToken, err := s.user.FeedToken(r.Context())
+	if err != nil {
+		return renderer.NewPage("", http.StatusInternalServerError, nil), err
+	}
+
+	reports, err := s.report.ListLatest(r.Context())
+	if err != nil {
+		slog.LogAttrs(r.Context(), slog.LevelWarn, "list latest reports", slog.Any("error", err))
+	}
+
 	content := map[string]any{
-		"Root":     appPath,
-		"Ketchups": ketchups,
+		"Root":      appPath,
+		"Reports":   reports,
+		"Ketchups":  ketchups,
+		"Channels":  channels,
+		"FeedToken": feedToken,
+		"User":      model.ReadUser(r.Context()),
+		"Hours":     hours,
+		"Weekdays":  weekdays,
 	}
 
 	ketchupsCount := uint64(len(ketchups))
@@ -0,0 +1,45 @@
+package ketchup
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	httpModel "github.com/ViBiOh/httputils/v4/pkg/model"
+	"github.com/ViBiOh/httputils/v4/pkg/renderer"
+)
+
+func (s Service) Settings() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			s.renderer.Error(w, r, nil, httpModel.WrapMethodNotAllowed(fmt.Errorf("invalid method %s", r.Method)))
+			return
+		}
+
+		if err := r.ParseForm(); err != nil {
+			s.renderer.Error(w, r, nil, httpModel.WrapInvalid(err))
+			return
+		}
+
+		hour, err := strconv.ParseUint(r.FormValue("hour"), 10, 8)
+		if err != nil {
+			s.renderer.Error(w, r, nil, httpModel.WrapInvalid(fmt.Errorf("parse hour: %w", err)))
+			return
+		}
+
+		weeklyDay, err := strconv.ParseUint(r.FormValue("weekly_day"), 10, 8)
+		if err != nil {
+			s.renderer.Error(w, r, nil, httpModel.WrapInvalid(fmt.Errorf("parse weekly day: %w", err)))
+			return
+		}
+
+		if _, err := s.user.UpdateSettings(r.Context(), strings.TrimSpace(r.FormValue("timezone")), uint(hour), time.Weekday(weeklyDay)); err != nil {
+			s.renderer.Error(w, r, nil, err)
+			return
+		}
+
+		s.renderer.Redirect(w, r, fmt.Sprintf("%s/", appPath), renderer.NewSuccessMessage("Settings updated with success!"))
+	})
+}
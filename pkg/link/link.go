@@ -0,0 +1,170 @@
+package link
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"flag"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ViBiOh/flags"
+	"github.com/ViBiOh/ketchup/pkg/model"
+)
+
+const (
+	// Path is where the links are handled by the web server
+	Path = "/action"
+
+	// SnoozeDuration is how long a ketchup is snoozed from the click on its link
+	SnoozeDuration = 7 * 24 * time.Hour
+)
+
+type Action string
+
+const (
+	Update      Action = "update"
+	Snooze      Action = "snooze"
+	Unsubscribe Action = "unsubscribe"
+)
+
+var (
+	ErrInvalidSignature = errors.New("invalid signature")
+	ErrExpired          = errors.New("link expired")
+	ErrUnknownAction    = errors.New("unknown action")
+)
+
+// Link is an action on a ketchup that can be done without being logged in
+type Link struct {
+	Expires      time.Time
+	Action       Action
+	Pattern      string
+	Version      string
+	UserID       model.Identifier
+	RepositoryID model.Identifier
+}
+
+type Service struct {
+	url      string
+	secret   []byte
+	validity time.Duration
+}
+
+type Config struct {
+	URL      string
+	Secret   string
+	Validity time.Duration
+}
+
+func Flags(fs *flag.FlagSet, prefix string) *Config {
+	var config Config
+
+	flags.New("URL", "Public URL of Ketchup").Prefix(prefix).DocPrefix("link").StringVar(fs, &config.URL, "https://ketchup.vibioh.fr", nil)
+	flags.New("Secret", "Secret for signing one-click links, disabled if empty").Prefix(prefix).DocPrefix("link").StringVar(fs, &config.Secret, "", nil)
+	flags.New("Validity", "Validity of one-click links").Prefix(prefix).DocPrefix("link").DurationVar(fs, &config.Validity, time.Hour*24*30, nil)
+
+	return &config
+}
+
+func New(config *Config) Service {
+	return Service{
+		url:      strings.TrimSuffix(config.URL, "/"),
+		secret:   []byte(config.Secret),
+		validity: config.Validity,
+	}
+}
+
+func (s Service) Enabled() bool {
+	return len(s.secret) != 0
+}
+
+// URL gives the signed link of the action, valid from now for the configured duration
+func (s Service) URL(action Action, userID, repositoryID model.Identifier, pattern, version string, now time.Time) string {
+	if !s.Enabled() {
+		return ""
+	}
+
+	link := Link{
+		Action:       action,
+		UserID:       userID,
+		RepositoryID: repositoryID,
+		Pattern:      pattern,
+		Version:      version,
+		Expires:      now.Add(s.validity),
+	}
+
+	values := link.values()
+	values.Set("signature", s.sign(link))
+
+	return fmt.Sprintf("%s%s?%s", s.url, Path, values.Encode())
+}
+
+// Parse reads and checks the link from the query or form values
+func (s Service) Parse(values url.Values, now time.Time) (Link, error) {
+	if !s.Enabled() {
+		return Link{}, ErrInvalidSignature
+	}
+
+	var link Link
+
+	link.Action = Action(values.Get("action"))
+	switch link.Action {
+	case Update, Snooze, Unsubscribe:
+	default:
+		return Link{}, ErrUnknownAction
+	}
+
+	userID, err := strconv.ParseUint(values.Get("user"), 10, 64)
+	if err != nil {
+		return Link{}, fmt.Errorf("parse user: %w", err)
+	}
+	link.UserID = model.Identifier(userID)
+
+	repositoryID, err := strconv.ParseUint(values.Get("repository"), 10, 64)
+	if err != nil {
+		return Link{}, fmt.Errorf("parse repository: %w", err)
+	}
+	link.RepositoryID = model.Identifier(repositoryID)
+
+	expires, err := strconv.ParseInt(values.Get("expires"), 10, 64)
+	if err != nil {
+		return Link{}, fmt.Errorf("parse expires: %w", err)
+	}
+	link.Expires = time.Unix(expires, 0)
+
+	link.Pattern = values.Get("pattern")
+	link.Version = values.Get("version")
+
+	if !hmac.Equal([]byte(values.Get("signature")), []byte(s.sign(link))) {
+		return Link{}, ErrInvalidSignature
+	}
+
+	if !now.Before(link.Expires) {
+		return Link{}, ErrExpired
+	}
+
+	return link, nil
+}
+
+func (l Link) values() url.Values {
+	values := url.Values{}
+	values.Set("action", string(l.Action))
+	values.Set("user", strconv.FormatUint(uint64(l.UserID), 10))
+	values.Set("repository", strconv.FormatUint(uint64(l.RepositoryID), 10))
+	values.Set("pattern", l.Pattern)
+	values.Set("version", l.Version)
+	values.Set("expires", strconv.FormatInt(l.Expires.Unix(), 10))
+
+	return values
+}
+
+func (s Service) sign(link Link) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(link.values().Encode()))
+
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,148 @@
+package link
+
+import (
+	"errors"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ViBiOh/ketchup/pkg/model"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)
+	instance := New(&Config{URL: "https://ketchup.localhost/", Secret: "secret", Validity: time.Hour})
+
+	signed := func(action Action, mutate func(url.Values)) url.Values {
+		rawURL := instance.URL(action, 1, 2, model.DefaultPattern, "1.1.0", now)
+		if !strings.HasPrefix(rawURL, "https://ketchup.localhost/action?") {
+			t.Fatalf("URL() = `%s`, want public URL and path", rawURL)
+		}
+
+		parsed, err := url.Parse(rawURL)
+		if err != nil {
+			t.Fatalf("parse URL: %s", err)
+		}
+
+		values := parsed.Query()
+		if mutate != nil {
+			mutate(values)
+		}
+
+		return values
+	}
+
+	type args struct {
+		values url.Values
+		now    time.Time
+	}
+
+	cases := map[string]struct {
+		instance Service
+		args     args
+		want     Link
+		wantErr  error
+	}{
+		"valid": {
+			instance,
+			args{
+				values: signed(Update, nil),
+				now:    now,
+			},
+			Link{
+				Action:       Update,
+				UserID:       1,
+				RepositoryID: 2,
+				Pattern:      model.DefaultPattern,
+				Version:      "1.1.0",
+				Expires:      time.Unix(now.Add(time.Hour).Unix(), 0),
+			},
+			nil,
+		},
+		"snooze": {
+			instance,
+			args{
+				values: signed(Snooze, nil),
+				now:    now,
+			},
+			Link{
+				Action:       Snooze,
+				UserID:       1,
+				RepositoryID: 2,
+				Pattern:      model.DefaultPattern,
+				Version:      "1.1.0",
+				Expires:      time.Unix(now.Add(time.Hour).Unix(), 0),
+			},
+			nil,
+		},
+		"tampered": {
+			instance,
+			args{
+				values: signed(Update, func(values url.Values) { values.Set("version", "2.0.0") }),
+				now:    now,
+			},
+			Link{},
+			ErrInvalidSignature,
+		},
+		"expired": {
+			instance,
+			args{
+				values: signed(Update, nil),
+				now:    now.Add(time.Hour),
+			},
+			Link{},
+			ErrExpired,
+		},
+		"other secret": {
+			New(&Config{Secret: "other", Validity: time.Hour}),
+			args{
+				values: signed(Update, nil),
+				now:    now,
+			},
+			Link{},
+			ErrInvalidSignature,
+		},
+		"disabled": {
+			New(&Config{}),
+			args{
+				values: signed(Update, nil),
+				now:    now,
+			},
+			Link{},
+			ErrInvalidSignature,
+		},
+		"unknown action": {
+			instance,
+			args{
+				values: signed(Update, func(values url.Values) { values.Set("action", "delete") }),
+				now:    now,
+			},
+			Link{},
+			ErrUnknownAction,
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotErr := testCase.instance.Parse(testCase.args.values, testCase.args.now)
+
+			failed := false
+
+			if !errors.Is(gotErr, testCase.wantErr) {
+				failed = true
+			} else if !reflect.DeepEqual(got, testCase.want) {
+				failed = true
+			}
+
+			if failed {
+				t.Errorf("Parse() = (%+v, `%s`), want (%+v, `%s`)", got, gotErr, testCase.want, testCase.wantErr)
+			}
+		})
+	}
+}
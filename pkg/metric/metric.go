@@ -0,0 +1,123 @@
+package metric
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ViBiOh/ketchup/pkg/model"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	successState = "success"
+	failureState = "failure"
+)
+
+// Service records the activity of the checks and of the notifications, doing nothing when telemetry isn't configured
+type Service struct {
+	providerDuration metric.Float64Histogram
+	providerErrors   metric.Int64Counter
+	repositories     metric.Int64Counter
+	releases         metric.Int64Counter
+	notifications    metric.Int64Counter
+	autoUpdates      metric.Int64Counter
+}
+
+func New(meterProvider metric.MeterProvider) (Service, error) {
+	var output Service
+	var err error
+
+	if meterProvider == nil {
+		return output, nil
+	}
+
+	meter := meterProvider.Meter("github.com/ViBiOh/ketchup/pkg/metric")
+
+	output.providerDuration, err = meter.Float64Histogram("ketchup.provider.duration", metric.WithUnit("s"), metric.WithDescription("Duration of the requests of latest versions to the providers"))
+	if err != nil {
+		return output, fmt.Errorf("create provider duration: %w", err)
+	}
+
+	output.providerErrors, err = meter.Int64Counter("ketchup.provider.errors", metric.WithDescription("Failed requests of latest versions to the providers"))
+	if err != nil {
+		return output, fmt.Errorf("create provider errors: %w", err)
+	}
+
+	output.repositories, err = meter.Int64Counter("ketchup.repositories", metric.WithDescription("Repositories checked"))
+	if err != nil {
+		return output, fmt.Errorf("create repositories: %w", err)
+	}
+
+	output.releases, err = meter.Int64Counter("ketchup.releases", metric.WithDescription("New releases found"))
+	if err != nil {
+		return output, fmt.Errorf("create releases: %w", err)
+	}
+
+	output.notifications, err = meter.Int64Counter("ketchup.notifications", metric.WithDescription("Notifications sent or failed, per channel"))
+	if err != nil {
+		return output, fmt.Errorf("create notifications: %w", err)
+	}
+
+	output.autoUpdates, err = meter.Int64Counter("ketchup.auto_updates", metric.WithDescription("Ketchups updated on notification"))
+	if err != nil {
+		return output, fmt.Errorf("create auto updates: %w", err)
+	}
+
+	return output, nil
+}
+
+// ProviderRequest records a request of latest versions, the failure being the class of its error, empty on success
+func (s Service) ProviderRequest(ctx context.Context, kind model.RepositoryKind, duration time.Duration, failure string) {
+	if s.providerDuration == nil {
+		return
+	}
+
+	kindAttribute := attribute.String("kind", strings.ToLower(kind.String()))
+
+	s.providerDuration.Record(ctx, duration.Seconds(), metric.WithAttributes(kindAttribute))
+
+	if len(failure) != 0 {
+		s.providerErrors.Add(ctx, 1, metric.WithAttributes(kindAttribute, attribute.String("class", failure)))
+	}
+}
+
+func (s Service) RepositoryChecked(ctx context.Context, kind model.RepositoryKind, newReleases int, err error) {
+	if s.repositories == nil {
+		return
+	}
+
+	kindAttribute := attribute.String("kind", strings.ToLower(kind.String()))
+
+	s.repositories.Add(ctx, 1, metric.WithAttributes(kindAttribute, stateAttribute(err)))
+
+	if newReleases != 0 {
+		s.releases.Add(ctx, int64(newReleases), metric.WithAttributes(kindAttribute))
+	}
+}
+
+func (s Service) Notification(ctx context.Context, kind model.ChannelKind, err error) {
+	if s.notifications == nil {
+		return
+	}
+
+	s.notifications.Add(ctx, 1, metric.WithAttributes(attribute.String("kind", strings.ToLower(kind.String())), stateAttribute(err)))
+}
+
+func (s Service) AutoUpdate(ctx context.Context, err error) {
+	if s.autoUpdates == nil {
+		return
+	}
+
+	s.autoUpdates.Add(ctx, 1, metric.WithAttributes(stateAttribute(err)))
+}
+
+func stateAttribute(err error) attribute.KeyValue {
+	if err != nil {
+		return attribute.String("state", failureState)
+	}
+
+	return attribute.String("state", successState)
+}
@@ -0,0 +1,161 @@
+package metric
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ViBiOh/ketchup/pkg/model"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+type recorder struct {
+	values map[string]float64
+	mutex  sync.Mutex
+}
+
+func (r *recorder) add(name string, value float64, attributes attribute.Set) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.values[name+"{"+attributes.Encoded(attribute.DefaultEncoder())+"}"] += value
+}
+
+type fakeMeterProvider struct {
+	noop.MeterProvider
+	recorder *recorder
+}
+
+func (p fakeMeterProvider) Meter(string, ...metric.MeterOption) metric.Meter {
+	return fakeMeter{recorder: p.recorder}
+}
+
+type fakeMeter struct {
+	noop.Meter
+	recorder *recorder
+}
+
+func (m fakeMeter) Int64Counter(name string, _ ...metric.Int64CounterOption) (metric.Int64Counter, error) {
+	return fakeCounter{name: name, recorder: m.recorder}, nil
+}
+
+func (m fakeMeter) Float64Histogram(name string, _ ...metric.Float64HistogramOption) (metric.Float64Histogram, error) {
+	return fakeHistogram{name: name, recorder: m.recorder}, nil
+}
+
+type fakeCounter struct {
+	noop.Int64Counter
+	recorder *recorder
+	name     string
+}
+
+func (c fakeCounter) Add(_ context.Context, incr int64, options ...metric.AddOption) {
+	c.recorder.add(c.name, float64(incr), metric.NewAddConfig(options).Attributes())
+}
+
+type fakeHistogram struct {
+	noop.Float64Histogram
+	recorder *recorder
+	name     string
+}
+
+func (h fakeHistogram) Record(_ context.Context, value float64, options ...metric.RecordOption) {
+	h.recorder.add(h.name, value, metric.NewRecordConfig(options).Attributes())
+}
+
+func TestService(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		record func(context.Context, Service)
+		want   map[string]float64
+	}{
+		"provider success": {
+			func(ctx context.Context, instance Service) {
+				instance.ProviderRequest(ctx, model.Github, 2*time.Second, "")
+			},
+			map[string]float64{
+				"ketchup.provider.duration{kind=github}": 2,
+			},
+		},
+		"provider failure": {
+			func(ctx context.Context, instance Service) {
+				instance.ProviderRequest(ctx, model.Docker, time.Second, "timeout")
+			},
+			map[string]float64{
+				"ketchup.provider.duration{kind=docker}":             1,
+				"ketchup.provider.errors{class=timeout,kind=docker}": 1,
+			},
+		},
+		"repositories": {
+			func(ctx context.Context, instance Service) {
+				instance.RepositoryChecked(ctx, model.NPM, 2, nil)
+				instance.RepositoryChecked(ctx, model.NPM, 0, nil)
+				instance.RepositoryChecked(ctx, model.NPM, 0, errors.New("failed"))
+			},
+			map[string]float64{
+				"ketchup.repositories{kind=npm,state=success}": 2,
+				"ketchup.repositories{kind=npm,state=failure}": 1,
+				"ketchup.releases{kind=npm}":                   2,
+			},
+		},
+		"notifications": {
+			func(ctx context.Context, instance Service) {
+				instance.Notification(ctx, model.Email, nil)
+				instance.Notification(ctx, model.Email, errors.New("failed"))
+			},
+			map[string]float64{
+				"ketchup.notifications{kind=email,state=success}": 1,
+				"ketchup.notifications{kind=email,state=failure}": 1,
+			},
+		},
+		"auto updates": {
+			func(ctx context.Context, instance Service) {
+				instance.AutoUpdate(ctx, nil)
+			},
+			map[string]float64{
+				"ketchup.auto_updates{state=success}": 1,
+			},
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			recorder := &recorder{values: make(map[string]float64)}
+
+			instance, err := New(fakeMeterProvider{recorder: recorder})
+			if err != nil {
+				t.Fatalf("New() = `%s`", err)
+			}
+
+			testCase.record(context.TODO(), instance)
+
+			if !reflect.DeepEqual(recorder.values, testCase.want) {
+				t.Errorf("record() = %+v, want %+v", recorder.values, testCase.want)
+			}
+		})
+	}
+}
+
+func TestDisabled(t *testing.T) {
+	t.Parallel()
+
+	instance, err := New(nil)
+	if err != nil {
+		t.Fatalf("New() = `%s`", err)
+	}
+
+	ctx := context.TODO()
+
+	instance.ProviderRequest(ctx, model.Github, time.Second, "timeout")
+	instance.RepositoryChecked(ctx, model.Github, 1, nil)
+	instance.Notification(ctx, model.Email, nil)
+	instance.AutoUpdate(ctx, nil)
+}
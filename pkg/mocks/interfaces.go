@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -source interfaces.go -destination ../mocks/interfaces.go -package mocks -mock_names Mailer=Mailer,AuthService=AuthService,UserService=UserService,UserStore=UserStore,GenericProvider=GenericProvider,HelmProvider=HelmProvider,RepositoryService=RepositoryService,RepositoryStore=RepositoryStore,KetchupService=KetchupService,KetchupStore=KetchupStore
+//	mockgen -source interfaces.go -destination ../mocks/interfaces.go -package mocks -mock_names Mailer=Mailer,AuthService=AuthService,UserService=UserService,UserStore=UserStore,Redis=Redis,GenericProvider=GenericProvider,HelmProvider=HelmProvider,YankProvider=YankProvider,DetailProvider=DetailProvider,RepositoryService=RepositoryService,RepositoryStore=RepositoryStore,ReleaseService=ReleaseService,ReleaseStore=ReleaseStore,KetchupService=KetchupService,KetchupStore=KetchupStore,Notifier=Notifier,ChannelService=ChannelService,ChannelStore=ChannelStore,WebhookStore=WebhookStore,NotificationService=NotificationService,NotificationStore=NotificationStore,ReportService=ReportService,ReportStore=ReportStore,Checker=Checker
 //
 
 // Package mocks is a generated GoMock package.
@@ -12,6 +12,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	model "github.com/ViBiOh/auth/v3/pkg/model"
 	model0 "github.com/ViBiOh/ketchup/pkg/model"
@@ -232,6 +233,21 @@ func (mr *UserStoreMockRecorder) GetByEmail(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByEmail", reflect.TypeOf((*UserStore)(nil).GetByEmail), arg0, arg1)
 }
 
+// GetByFeedToken mocks base method.
+func (m *UserStore) GetByFeedToken(arg0 context.Context, arg1 string) (model0.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByFeedToken", arg0, arg1)
+	ret0, _ := ret[0].(model0.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByFeedToken indicates an expected call of GetByFeedToken.
+func (mr *UserStoreMockRecorder) GetByFeedToken(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByFeedToken", reflect.TypeOf((*UserStore)(nil).GetByFeedToken), arg0, arg1)
+}
+
 // GetByLoginID mocks base method.
 func (m *UserStore) GetByLoginID(arg0 context.Context, arg1 string) (model0.User, error) {
 	m.ctrl.T.Helper()
@@ -247,6 +263,88 @@ func (mr *UserStoreMockRecorder) GetByLoginID(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByLoginID", reflect.TypeOf((*UserStore)(nil).GetByLoginID), arg0, arg1)
 }
 
+// GetFeedToken mocks base method.
+func (m *UserStore) GetFeedToken(arg0 context.Context, arg1 model0.Identifier) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeedToken", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeedToken indicates an expected call of GetFeedToken.
+func (mr *UserStoreMockRecorder) GetFeedToken(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeedToken", reflect.TypeOf((*UserStore)(nil).GetFeedToken), arg0, arg1)
+}
+
+// UpdateFeedToken mocks base method.
+func (m *UserStore) UpdateFeedToken(arg0 context.Context, arg1 model0.Identifier, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFeedToken", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFeedToken indicates an expected call of UpdateFeedToken.
+func (mr *UserStoreMockRecorder) UpdateFeedToken(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFeedToken", reflect.TypeOf((*UserStore)(nil).UpdateFeedToken), arg0, arg1, arg2)
+}
+
+// UpdateSettings mocks base method.
+func (m *UserStore) UpdateSettings(arg0 context.Context, arg1 model0.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSettings", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSettings indicates an expected call of UpdateSettings.
+func (mr *UserStoreMockRecorder) UpdateSettings(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSettings", reflect.TypeOf((*UserStore)(nil).UpdateSettings), arg0, arg1)
+}
+
+// Redis is a mock of Redis interface.
+type Redis struct {
+	ctrl     *gomock.Controller
+	recorder *RedisMockRecorder
+	isgomock struct{}
+}
+
+// RedisMockRecorder is the mock recorder for Redis.
+type RedisMockRecorder struct {
+	mock *Redis
+}
+
+// NewRedis creates a new mock instance.
+func NewRedis(ctrl *gomock.Controller) *Redis {
+	mock := &Redis{ctrl: ctrl}
+	mock.recorder = &RedisMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Redis) EXPECT() *RedisMockRecorder {
+	return m.recorder
+}
+
+// Exclusive mocks base method.
+func (m *Redis) Exclusive(arg0 context.Context, arg1 string, arg2 time.Duration, arg3 func(context.Context) error) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exclusive", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exclusive indicates an expected call of Exclusive.
+func (mr *RedisMockRecorder) Exclusive(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exclusive", reflect.TypeOf((*Redis)(nil).Exclusive), arg0, arg1, arg2, arg3)
+}
+
 // GenericProvider is a mock of GenericProvider interface.
 type GenericProvider struct {
 	ctrl     *gomock.Controller
@@ -286,6 +384,115 @@ func (mr *GenericProviderMockRecorder) LatestVersions(arg0, arg1, arg2 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestVersions", reflect.TypeOf((*GenericProvider)(nil).LatestVersions), arg0, arg1, arg2)
 }
 
+// YankProvider is a mock of YankProvider interface.
+type YankProvider struct {
+	ctrl     *gomock.Controller
+	recorder *YankProviderMockRecorder
+	isgomock struct{}
+}
+
+// YankProviderMockRecorder is the mock recorder for YankProvider.
+type YankProviderMockRecorder struct {
+	mock *YankProvider
+}
+
+// NewYankProvider creates a new mock instance.
+func NewYankProvider(ctrl *gomock.Controller) *YankProvider {
+	mock := &YankProvider{ctrl: ctrl}
+	mock.recorder = &YankProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *YankProvider) EXPECT() *YankProviderMockRecorder {
+	return m.recorder
+}
+
+// LatestVersions mocks base method.
+func (m *YankProvider) LatestVersions(arg0 context.Context, arg1 string, arg2 []string) (map[string]semver.Version, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestVersions", arg0, arg1, arg2)
+	ret0, _ := ret[0].(map[string]semver.Version)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestVersions indicates an expected call of LatestVersions.
+func (mr *YankProviderMockRecorder) LatestVersions(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestVersions", reflect.TypeOf((*YankProvider)(nil).LatestVersions), arg0, arg1, arg2)
+}
+
+// Versions mocks base method.
+func (m *YankProvider) Versions(arg0 context.Context, arg1 string, arg2 []string) (map[string]semver.Version, []string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Versions", arg0, arg1, arg2)
+	ret0, _ := ret[0].(map[string]semver.Version)
+	ret1, _ := ret[1].([]string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Versions indicates an expected call of Versions.
+func (mr *YankProviderMockRecorder) Versions(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Versions", reflect.TypeOf((*YankProvider)(nil).Versions), arg0, arg1, arg2)
+}
+
+// DetailProvider is a mock of DetailProvider interface.
+type DetailProvider struct {
+	ctrl     *gomock.Controller
+	recorder *DetailProviderMockRecorder
+	isgomock struct{}
+}
+
+// DetailProviderMockRecorder is the mock recorder for DetailProvider.
+type DetailProviderMockRecorder struct {
+	mock *DetailProvider
+}
+
+// NewDetailProvider creates a new mock instance.
+func NewDetailProvider(ctrl *gomock.Controller) *DetailProvider {
+	mock := &DetailProvider{ctrl: ctrl}
+	mock.recorder = &DetailProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *DetailProvider) EXPECT() *DetailProviderMockRecorder {
+	return m.recorder
+}
+
+// LatestVersions mocks base method.
+func (m *DetailProvider) LatestVersions(arg0 context.Context, arg1 string, arg2 []string) (map[string]semver.Version, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestVersions", arg0, arg1, arg2)
+	ret0, _ := ret[0].(map[string]semver.Version)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestVersions indicates an expected call of LatestVersions.
+func (mr *DetailProviderMockRecorder) LatestVersions(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestVersions", reflect.TypeOf((*DetailProvider)(nil).LatestVersions), arg0, arg1, arg2)
+}
+
+// ReleaseDetail mocks base method.
+func (m *DetailProvider) ReleaseDetail(arg0 context.Context, arg1, arg2 string) (model0.ReleaseDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseDetail", arg0, arg1, arg2)
+	ret0, _ := ret[0].(model0.ReleaseDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseDetail indicates an expected call of ReleaseDetail.
+func (mr *DetailProviderMockRecorder) ReleaseDetail(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseDetail", reflect.TypeOf((*DetailProvider)(nil).ReleaseDetail), arg0, arg1, arg2)
+}
+
 // HelmProvider is a mock of HelmProvider interface.
 type HelmProvider struct {
 	ctrl     *gomock.Controller
@@ -409,18 +616,38 @@ func (mr *RepositoryServiceMockRecorder) LatestVersions(arg0, arg1 any) *gomock.
 }
 
 // List mocks base method.
-func (m *RepositoryService) List(arg0 context.Context, arg1 uint, arg2 model0.Identifier) ([]model0.Repository, error) {
+func (m *RepositoryService) List(arg0 context.Context, arg1 uint, arg2 model0.Identifier, arg3 ...model0.RepositoryKind) ([]model0.Repository, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", arg0, arg1, arg2)
+	varargs := []any{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "List", varargs...)
 	ret0, _ := ret[0].([]model0.Repository)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // List indicates an expected call of List.
-func (mr *RepositoryServiceMockRecorder) List(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *RepositoryServiceMockRecorder) List(arg0, arg1, arg2 any, arg3 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*RepositoryService)(nil).List), varargs...)
+}
+
+// ReleaseDetail mocks base method.
+func (m *RepositoryService) ReleaseDetail(arg0 context.Context, arg1 model0.Repository, arg2 string) (model0.ReleaseDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseDetail", arg0, arg1, arg2)
+	ret0, _ := ret[0].(model0.ReleaseDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseDetail indicates an expected call of ReleaseDetail.
+func (mr *RepositoryServiceMockRecorder) ReleaseDetail(arg0, arg1, arg2 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*RepositoryService)(nil).List), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseDetail", reflect.TypeOf((*RepositoryService)(nil).ReleaseDetail), arg0, arg1, arg2)
 }
 
 // Suggest mocks base method.
@@ -452,6 +679,22 @@ func (mr *RepositoryServiceMockRecorder) Update(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*RepositoryService)(nil).Update), arg0, arg1)
 }
 
+// Versions mocks base method.
+func (m *RepositoryService) Versions(arg0 context.Context, arg1 model0.Repository) (map[string]semver.Version, []string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Versions", arg0, arg1)
+	ret0, _ := ret[0].(map[string]semver.Version)
+	ret1, _ := ret[1].([]string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Versions indicates an expected call of Versions.
+func (mr *RepositoryServiceMockRecorder) Versions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Versions", reflect.TypeOf((*RepositoryService)(nil).Versions), arg0, arg1)
+}
+
 // RepositoryStore is a mock of RepositoryStore interface.
 type RepositoryStore struct {
 	ctrl     *gomock.Controller
@@ -564,18 +807,23 @@ func (mr *RepositoryStoreMockRecorder) GetByName(ctx, repositoryKind, name, part
 }
 
 // List mocks base method.
-func (m *RepositoryStore) List(ctx context.Context, pageSize uint, last model0.Identifier) ([]model0.Repository, error) {
+func (m *RepositoryStore) List(ctx context.Context, pageSize uint, last model0.Identifier, kinds ...model0.RepositoryKind) ([]model0.Repository, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", ctx, pageSize, last)
+	varargs := []any{ctx, pageSize, last}
+	for _, a := range kinds {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "List", varargs...)
 	ret0, _ := ret[0].([]model0.Repository)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // List indicates an expected call of List.
-func (mr *RepositoryStoreMockRecorder) List(ctx, pageSize, last any) *gomock.Call {
+func (mr *RepositoryStoreMockRecorder) List(ctx, pageSize, last any, kinds ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*RepositoryStore)(nil).List), ctx, pageSize, last)
+	varargs := append([]any{ctx, pageSize, last}, kinds...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*RepositoryStore)(nil).List), varargs...)
 }
 
 // Suggest mocks base method.
@@ -607,279 +855,355 @@ func (mr *RepositoryStoreMockRecorder) UpdateVersions(ctx, o any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVersions", reflect.TypeOf((*RepositoryStore)(nil).UpdateVersions), ctx, o)
 }
 
-// KetchupService is a mock of KetchupService interface.
-type KetchupService struct {
+// ReleaseService is a mock of ReleaseService interface.
+type ReleaseService struct {
 	ctrl     *gomock.Controller
-	recorder *KetchupServiceMockRecorder
+	recorder *ReleaseServiceMockRecorder
 	isgomock struct{}
 }
 
-// KetchupServiceMockRecorder is the mock recorder for KetchupService.
-type KetchupServiceMockRecorder struct {
-	mock *KetchupService
+// ReleaseServiceMockRecorder is the mock recorder for ReleaseService.
+type ReleaseServiceMockRecorder struct {
+	mock *ReleaseService
 }
 
-// NewKetchupService creates a new mock instance.
-func NewKetchupService(ctrl *gomock.Controller) *KetchupService {
-	mock := &KetchupService{ctrl: ctrl}
-	mock.recorder = &KetchupServiceMockRecorder{mock}
+// NewReleaseService creates a new mock instance.
+func NewReleaseService(ctrl *gomock.Controller) *ReleaseService {
+	mock := &ReleaseService{ctrl: ctrl}
+	mock.recorder = &ReleaseServiceMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *KetchupService) EXPECT() *KetchupServiceMockRecorder {
+func (m *ReleaseService) EXPECT() *ReleaseServiceMockRecorder {
 	return m.recorder
 }
 
-// Create mocks base method.
-func (m *KetchupService) Create(ctx context.Context, item model0.Ketchup) (model0.Ketchup, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, item)
-	ret0, _ := ret[0].(model0.Ketchup)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// Create indicates an expected call of Create.
-func (mr *KetchupServiceMockRecorder) Create(ctx, item any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*KetchupService)(nil).Create), ctx, item)
-}
-
-// Delete mocks base method.
-func (m *KetchupService) Delete(ctx context.Context, item model0.Ketchup) error {
+// DoAtomic mocks base method.
+func (m *ReleaseService) DoAtomic(ctx context.Context, action func(context.Context) error) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, item)
+	ret := m.ctrl.Call(m, "DoAtomic", ctx, action)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Delete indicates an expected call of Delete.
-func (mr *KetchupServiceMockRecorder) Delete(ctx, item any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*KetchupService)(nil).Delete), ctx, item)
-}
-
-// List mocks base method.
-func (m *KetchupService) List(ctx context.Context, pageSize uint, last string) ([]model0.Ketchup, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", ctx, pageSize, last)
-	ret0, _ := ret[0].([]model0.Ketchup)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// List indicates an expected call of List.
-func (mr *KetchupServiceMockRecorder) List(ctx, pageSize, last any) *gomock.Call {
+// DoAtomic indicates an expected call of DoAtomic.
+func (mr *ReleaseServiceMockRecorder) DoAtomic(ctx, action any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*KetchupService)(nil).List), ctx, pageSize, last)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DoAtomic", reflect.TypeOf((*ReleaseService)(nil).DoAtomic), ctx, action)
 }
 
-// ListForRepositories mocks base method.
-func (m *KetchupService) ListForRepositories(ctx context.Context, repositories []model0.Repository, frequencies ...model0.KetchupFrequency) ([]model0.Ketchup, error) {
+// ListForUser mocks base method.
+func (m *ReleaseService) ListForUser(ctx context.Context, user model0.User, count uint) ([]model0.Release, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, repositories}
-	for _, a := range frequencies {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "ListForRepositories", varargs...)
-	ret0, _ := ret[0].([]model0.Ketchup)
+	ret := m.ctrl.Call(m, "ListForUser", ctx, user, count)
+	ret0, _ := ret[0].([]model0.Release)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListForRepositories indicates an expected call of ListForRepositories.
-func (mr *KetchupServiceMockRecorder) ListForRepositories(ctx, repositories any, frequencies ...any) *gomock.Call {
+// ListForUser indicates an expected call of ListForUser.
+func (mr *ReleaseServiceMockRecorder) ListForUser(ctx, user, count any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, repositories}, frequencies...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListForRepositories", reflect.TypeOf((*KetchupService)(nil).ListForRepositories), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListForUser", reflect.TypeOf((*ReleaseService)(nil).ListForUser), ctx, user, count)
 }
 
-// ListOutdated mocks base method.
-func (m *KetchupService) ListOutdated(ctx context.Context, users ...model0.User) ([]model0.Ketchup, error) {
+// ListPending mocks base method.
+func (m *ReleaseService) ListPending(ctx context.Context) ([]model0.Release, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx}
-	for _, a := range users {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "ListOutdated", varargs...)
-	ret0, _ := ret[0].([]model0.Ketchup)
+	ret := m.ctrl.Call(m, "ListPending", ctx)
+	ret0, _ := ret[0].([]model0.Release)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListOutdated indicates an expected call of ListOutdated.
-func (mr *KetchupServiceMockRecorder) ListOutdated(ctx any, users ...any) *gomock.Call {
+// ListPending indicates an expected call of ListPending.
+func (mr *ReleaseServiceMockRecorder) ListPending(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, users...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOutdated", reflect.TypeOf((*KetchupService)(nil).ListOutdated), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPending", reflect.TypeOf((*ReleaseService)(nil).ListPending), ctx)
 }
 
-// Update mocks base method.
-func (m *KetchupService) Update(ctx context.Context, oldPattern string, item model0.Ketchup) (model0.Ketchup, error) {
+// ListYanked mocks base method.
+func (m *ReleaseService) ListYanked(ctx context.Context) ([]model0.Release, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", ctx, oldPattern, item)
-	ret0, _ := ret[0].(model0.Ketchup)
+	ret := m.ctrl.Call(m, "ListYanked", ctx)
+	ret0, _ := ret[0].([]model0.Release)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Update indicates an expected call of Update.
-func (mr *KetchupServiceMockRecorder) Update(ctx, oldPattern, item any) *gomock.Call {
+// ListYanked indicates an expected call of ListYanked.
+func (mr *ReleaseServiceMockRecorder) ListYanked(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*KetchupService)(nil).Update), ctx, oldPattern, item)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListYanked", reflect.TypeOf((*ReleaseService)(nil).ListYanked), ctx)
 }
 
-// UpdateAll mocks base method.
-func (m *KetchupService) UpdateAll(ctx context.Context) error {
+// MarkNotified mocks base method.
+func (m *ReleaseService) MarkNotified(ctx context.Context, releases []model0.Release) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateAll", ctx)
+	ret := m.ctrl.Call(m, "MarkNotified", ctx, releases)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpdateAll indicates an expected call of UpdateAll.
-func (mr *KetchupServiceMockRecorder) UpdateAll(ctx any) *gomock.Call {
+// MarkNotified indicates an expected call of MarkNotified.
+func (mr *ReleaseServiceMockRecorder) MarkNotified(ctx, releases any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAll", reflect.TypeOf((*KetchupService)(nil).UpdateAll), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNotified", reflect.TypeOf((*ReleaseService)(nil).MarkNotified), ctx, releases)
 }
 
-// UpdateVersion mocks base method.
-func (m *KetchupService) UpdateVersion(ctx context.Context, userID, repositoryID model0.Identifier, pattern, version string) error {
+// Record mocks base method.
+func (m *ReleaseService) Record(ctx context.Context, releases []model0.Release) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateVersion", ctx, userID, repositoryID, pattern, version)
+	ret := m.ctrl.Call(m, "Record", ctx, releases)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpdateVersion indicates an expected call of UpdateVersion.
-func (mr *KetchupServiceMockRecorder) UpdateVersion(ctx, userID, repositoryID, pattern, version any) *gomock.Call {
+// Record indicates an expected call of Record.
+func (mr *ReleaseServiceMockRecorder) Record(ctx, releases any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVersion", reflect.TypeOf((*KetchupService)(nil).UpdateVersion), ctx, userID, repositoryID, pattern, version)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*ReleaseService)(nil).Record), ctx, releases)
 }
 
-// KetchupStore is a mock of KetchupStore interface.
-type KetchupStore struct {
+// ReleaseStore is a mock of ReleaseStore interface.
+type ReleaseStore struct {
 	ctrl     *gomock.Controller
-	recorder *KetchupStoreMockRecorder
+	recorder *ReleaseStoreMockRecorder
 	isgomock struct{}
 }
 
-// KetchupStoreMockRecorder is the mock recorder for KetchupStore.
-type KetchupStoreMockRecorder struct {
-	mock *KetchupStore
+// ReleaseStoreMockRecorder is the mock recorder for ReleaseStore.
+type ReleaseStoreMockRecorder struct {
+	mock *ReleaseStore
 }
 
-// NewKetchupStore creates a new mock instance.
-func NewKetchupStore(ctrl *gomock.Controller) *KetchupStore {
-	mock := &KetchupStore{ctrl: ctrl}
-	mock.recorder = &KetchupStoreMockRecorder{mock}
+// NewReleaseStore creates a new mock instance.
+func NewReleaseStore(ctrl *gomock.Controller) *ReleaseStore {
+	mock := &ReleaseStore{ctrl: ctrl}
+	mock.recorder = &ReleaseStoreMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *KetchupStore) EXPECT() *KetchupStoreMockRecorder {
+func (m *ReleaseStore) EXPECT() *ReleaseStoreMockRecorder {
 	return m.recorder
 }
 
 // Create mocks base method.
-func (m *KetchupStore) Create(ctx context.Context, o model0.Ketchup) (model0.Identifier, error) {
+func (m *ReleaseStore) Create(ctx context.Context, o model0.Release) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Create", ctx, o)
-	ret0, _ := ret[0].(model0.Identifier)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
 // Create indicates an expected call of Create.
-func (mr *KetchupStoreMockRecorder) Create(ctx, o any) *gomock.Call {
+func (mr *ReleaseStoreMockRecorder) Create(ctx, o any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*KetchupStore)(nil).Create), ctx, o)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*ReleaseStore)(nil).Create), ctx, o)
 }
 
-// Delete mocks base method.
-func (m *KetchupStore) Delete(ctx context.Context, o model0.Ketchup) error {
+// DoAtomic mocks base method.
+func (m *ReleaseStore) DoAtomic(ctx context.Context, action func(context.Context) error) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, o)
+	ret := m.ctrl.Call(m, "DoAtomic", ctx, action)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Delete indicates an expected call of Delete.
-func (mr *KetchupStoreMockRecorder) Delete(ctx, o any) *gomock.Call {
+// DoAtomic indicates an expected call of DoAtomic.
+func (mr *ReleaseStoreMockRecorder) DoAtomic(ctx, action any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*KetchupStore)(nil).Delete), ctx, o)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DoAtomic", reflect.TypeOf((*ReleaseStore)(nil).DoAtomic), ctx, action)
 }
 
-// DoAtomic mocks base method.
-func (m *KetchupStore) DoAtomic(ctx context.Context, action func(context.Context) error) error {
+// ListByUser mocks base method.
+func (m *ReleaseStore) ListByUser(ctx context.Context, userID model0.Identifier, count uint) ([]model0.Release, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DoAtomic", ctx, action)
+	ret := m.ctrl.Call(m, "ListByUser", ctx, userID, count)
+	ret0, _ := ret[0].([]model0.Release)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByUser indicates an expected call of ListByUser.
+func (mr *ReleaseStoreMockRecorder) ListByUser(ctx, userID, count any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUser", reflect.TypeOf((*ReleaseStore)(nil).ListByUser), ctx, userID, count)
+}
+
+// ListPending mocks base method.
+func (m *ReleaseStore) ListPending(ctx context.Context) ([]model0.Release, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPending", ctx)
+	ret0, _ := ret[0].([]model0.Release)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPending indicates an expected call of ListPending.
+func (mr *ReleaseStoreMockRecorder) ListPending(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPending", reflect.TypeOf((*ReleaseStore)(nil).ListPending), ctx)
+}
+
+// ListYanked mocks base method.
+func (m *ReleaseStore) ListYanked(ctx context.Context) ([]model0.Release, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListYanked", ctx)
+	ret0, _ := ret[0].([]model0.Release)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListYanked indicates an expected call of ListYanked.
+func (mr *ReleaseStoreMockRecorder) ListYanked(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListYanked", reflect.TypeOf((*ReleaseStore)(nil).ListYanked), ctx)
+}
+
+// MarkNotified mocks base method.
+func (m *ReleaseStore) MarkNotified(ctx context.Context, o model0.Release) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkNotified", ctx, o)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DoAtomic indicates an expected call of DoAtomic.
-func (mr *KetchupStoreMockRecorder) DoAtomic(ctx, action any) *gomock.Call {
+// MarkNotified indicates an expected call of MarkNotified.
+func (mr *ReleaseStoreMockRecorder) MarkNotified(ctx, o any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DoAtomic", reflect.TypeOf((*KetchupStore)(nil).DoAtomic), ctx, action)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNotified", reflect.TypeOf((*ReleaseStore)(nil).MarkNotified), ctx, o)
 }
 
-// GetByRepository mocks base method.
-func (m *KetchupStore) GetByRepository(ctx context.Context, id model0.Identifier, pattern string, forUpdate bool) (model0.Ketchup, error) {
+// Yank mocks base method.
+func (m *ReleaseStore) Yank(ctx context.Context, o model0.Release) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByRepository", ctx, id, pattern, forUpdate)
+	ret := m.ctrl.Call(m, "Yank", ctx, o)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Yank indicates an expected call of Yank.
+func (mr *ReleaseStoreMockRecorder) Yank(ctx, o any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Yank", reflect.TypeOf((*ReleaseStore)(nil).Yank), ctx, o)
+}
+
+// KetchupService is a mock of KetchupService interface.
+type KetchupService struct {
+	ctrl     *gomock.Controller
+	recorder *KetchupServiceMockRecorder
+	isgomock struct{}
+}
+
+// KetchupServiceMockRecorder is the mock recorder for KetchupService.
+type KetchupServiceMockRecorder struct {
+	mock *KetchupService
+}
+
+// NewKetchupService creates a new mock instance.
+func NewKetchupService(ctrl *gomock.Controller) *KetchupService {
+	mock := &KetchupService{ctrl: ctrl}
+	mock.recorder = &KetchupServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *KetchupService) EXPECT() *KetchupServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *KetchupService) Create(ctx context.Context, item model0.Ketchup) (model0.Ketchup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, item)
 	ret0, _ := ret[0].(model0.Ketchup)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetByRepository indicates an expected call of GetByRepository.
-func (mr *KetchupStoreMockRecorder) GetByRepository(ctx, id, pattern, forUpdate any) *gomock.Call {
+// Create indicates an expected call of Create.
+func (mr *KetchupServiceMockRecorder) Create(ctx, item any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByRepository", reflect.TypeOf((*KetchupStore)(nil).GetByRepository), ctx, id, pattern, forUpdate)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*KetchupService)(nil).Create), ctx, item)
+}
+
+// Delete mocks base method.
+func (m *KetchupService) Delete(ctx context.Context, item model0.Ketchup) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, item)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *KetchupServiceMockRecorder) Delete(ctx, item any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*KetchupService)(nil).Delete), ctx, item)
 }
 
 // List mocks base method.
-func (m *KetchupStore) List(ctx context.Context, page uint, last string) ([]model0.Ketchup, error) {
+func (m *KetchupService) List(ctx context.Context, pageSize uint, last string) ([]model0.Ketchup, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", ctx, page, last)
+	ret := m.ctrl.Call(m, "List", ctx, pageSize, last)
 	ret0, _ := ret[0].([]model0.Ketchup)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // List indicates an expected call of List.
-func (mr *KetchupStoreMockRecorder) List(ctx, page, last any) *gomock.Call {
+func (mr *KetchupServiceMockRecorder) List(ctx, pageSize, last any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*KetchupStore)(nil).List), ctx, page, last)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*KetchupService)(nil).List), ctx, pageSize, last)
 }
 
-// ListByRepositoriesIDAndFrequencies mocks base method.
-func (m *KetchupStore) ListByRepositoriesIDAndFrequencies(ctx context.Context, ids []model0.Identifier, frequencies ...model0.KetchupFrequency) ([]model0.Ketchup, error) {
+// ListForRepositories mocks base method.
+func (m *KetchupService) ListForRepositories(ctx context.Context, repositories []model0.Repository, frequencies ...model0.KetchupFrequency) ([]model0.Ketchup, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, ids}
+	varargs := []any{ctx, repositories}
 	for _, a := range frequencies {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListByRepositoriesIDAndFrequencies", varargs...)
+	ret := m.ctrl.Call(m, "ListForRepositories", varargs...)
 	ret0, _ := ret[0].([]model0.Ketchup)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListByRepositoriesIDAndFrequencies indicates an expected call of ListByRepositoriesIDAndFrequencies.
-func (mr *KetchupStoreMockRecorder) ListByRepositoriesIDAndFrequencies(ctx, ids any, frequencies ...any) *gomock.Call {
+// ListForRepositories indicates an expected call of ListForRepositories.
+func (mr *KetchupServiceMockRecorder) ListForRepositories(ctx, repositories any, frequencies ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, ids}, frequencies...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByRepositoriesIDAndFrequencies", reflect.TypeOf((*KetchupStore)(nil).ListByRepositoriesIDAndFrequencies), varargs...)
+	varargs := append([]any{ctx, repositories}, frequencies...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListForRepositories", reflect.TypeOf((*KetchupService)(nil).ListForRepositories), varargs...)
+}
+
+// ListMatured mocks base method.
+func (m *KetchupService) ListMatured(ctx context.Context, now time.Time, frequencies ...model0.KetchupFrequency) ([]model0.Ketchup, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, now}
+	for _, a := range frequencies {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListMatured", varargs...)
+	ret0, _ := ret[0].([]model0.Ketchup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMatured indicates an expected call of ListMatured.
+func (mr *KetchupServiceMockRecorder) ListMatured(ctx, now any, frequencies ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, now}, frequencies...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMatured", reflect.TypeOf((*KetchupService)(nil).ListMatured), varargs...)
 }
 
 // ListOutdated mocks base method.
-func (m *KetchupStore) ListOutdated(ctx context.Context, usersIds ...model0.Identifier) ([]model0.Ketchup, error) {
+func (m *KetchupService) ListOutdated(ctx context.Context, users ...model0.User) ([]model0.Ketchup, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
-	for _, a := range usersIds {
+	for _, a := range users {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "ListOutdated", varargs...)
@@ -889,28 +1213,57 @@ func (m *KetchupStore) ListOutdated(ctx context.Context, usersIds ...model0.Iden
 }
 
 // ListOutdated indicates an expected call of ListOutdated.
-func (mr *KetchupStoreMockRecorder) ListOutdated(ctx any, usersIds ...any) *gomock.Call {
+func (mr *KetchupServiceMockRecorder) ListOutdated(ctx any, users ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, usersIds...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOutdated", reflect.TypeOf((*KetchupStore)(nil).ListOutdated), varargs...)
+	varargs := append([]any{ctx}, users...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOutdated", reflect.TypeOf((*KetchupService)(nil).ListOutdated), varargs...)
 }
 
-// Update mocks base method.
-func (m *KetchupStore) Update(ctx context.Context, o model0.Ketchup, oldPattern string) error {
+// SkipVersion mocks base method.
+func (m *KetchupService) SkipVersion(ctx context.Context, userID, repositoryID model0.Identifier, pattern, version string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", ctx, o, oldPattern)
+	ret := m.ctrl.Call(m, "SkipVersion", ctx, userID, repositoryID, pattern, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SkipVersion indicates an expected call of SkipVersion.
+func (mr *KetchupServiceMockRecorder) SkipVersion(ctx, userID, repositoryID, pattern, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SkipVersion", reflect.TypeOf((*KetchupService)(nil).SkipVersion), ctx, userID, repositoryID, pattern, version)
+}
+
+// Snooze mocks base method.
+func (m *KetchupService) Snooze(ctx context.Context, userID, repositoryID model0.Identifier, pattern string, until time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Snooze", ctx, userID, repositoryID, pattern, until)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// Snooze indicates an expected call of Snooze.
+func (mr *KetchupServiceMockRecorder) Snooze(ctx, userID, repositoryID, pattern, until any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Snooze", reflect.TypeOf((*KetchupService)(nil).Snooze), ctx, userID, repositoryID, pattern, until)
+}
+
+// Update mocks base method.
+func (m *KetchupService) Update(ctx context.Context, oldPattern string, item model0.Ketchup) (model0.Ketchup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, oldPattern, item)
+	ret0, _ := ret[0].(model0.Ketchup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // Update indicates an expected call of Update.
-func (mr *KetchupStoreMockRecorder) Update(ctx, o, oldPattern any) *gomock.Call {
+func (mr *KetchupServiceMockRecorder) Update(ctx, oldPattern, item any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*KetchupStore)(nil).Update), ctx, o, oldPattern)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*KetchupService)(nil).Update), ctx, oldPattern, item)
 }
 
 // UpdateAll mocks base method.
-func (m *KetchupStore) UpdateAll(ctx context.Context) error {
+func (m *KetchupService) UpdateAll(ctx context.Context) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdateAll", ctx)
 	ret0, _ := ret[0].(error)
@@ -918,13 +1271,55 @@ func (m *KetchupStore) UpdateAll(ctx context.Context) error {
 }
 
 // UpdateAll indicates an expected call of UpdateAll.
-func (mr *KetchupStoreMockRecorder) UpdateAll(ctx any) *gomock.Call {
+func (mr *KetchupServiceMockRecorder) UpdateAll(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAll", reflect.TypeOf((*KetchupStore)(nil).UpdateAll), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAll", reflect.TypeOf((*KetchupService)(nil).UpdateAll), ctx)
+}
+
+// UpdateFrequency mocks base method.
+func (m *KetchupService) UpdateFrequency(ctx context.Context, userID, repositoryID model0.Identifier, pattern string, frequency model0.KetchupFrequency) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFrequency", ctx, userID, repositoryID, pattern, frequency)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFrequency indicates an expected call of UpdateFrequency.
+func (mr *KetchupServiceMockRecorder) UpdateFrequency(ctx, userID, repositoryID, pattern, frequency any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFrequency", reflect.TypeOf((*KetchupService)(nil).UpdateFrequency), ctx, userID, repositoryID, pattern, frequency)
+}
+
+// UpdateNotifiedVersion mocks base method.
+func (m *KetchupService) UpdateNotifiedVersion(ctx context.Context, item model0.Ketchup, version string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNotifiedVersion", ctx, item, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateNotifiedVersion indicates an expected call of UpdateNotifiedVersion.
+func (mr *KetchupServiceMockRecorder) UpdateNotifiedVersion(ctx, item, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNotifiedVersion", reflect.TypeOf((*KetchupService)(nil).UpdateNotifiedVersion), ctx, item, version)
+}
+
+// UpdateRemindedAt mocks base method.
+func (m *KetchupService) UpdateRemindedAt(ctx context.Context, item model0.Ketchup, remindedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRemindedAt", ctx, item, remindedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRemindedAt indicates an expected call of UpdateRemindedAt.
+func (mr *KetchupServiceMockRecorder) UpdateRemindedAt(ctx, item, remindedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRemindedAt", reflect.TypeOf((*KetchupService)(nil).UpdateRemindedAt), ctx, item, remindedAt)
 }
 
 // UpdateVersion mocks base method.
-func (m *KetchupStore) UpdateVersion(ctx context.Context, userID, repositoryID model0.Identifier, pattern, version string) error {
+func (m *KetchupService) UpdateVersion(ctx context.Context, userID, repositoryID model0.Identifier, pattern, version string) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdateVersion", ctx, userID, repositoryID, pattern, version)
 	ret0, _ := ret[0].(error)
@@ -932,7 +1327,928 @@ func (m *KetchupStore) UpdateVersion(ctx context.Context, userID, repositoryID m
 }
 
 // UpdateVersion indicates an expected call of UpdateVersion.
-func (mr *KetchupStoreMockRecorder) UpdateVersion(ctx, userID, repositoryID, pattern, version any) *gomock.Call {
+func (mr *KetchupServiceMockRecorder) UpdateVersion(ctx, userID, repositoryID, pattern, version any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVersion", reflect.TypeOf((*KetchupStore)(nil).UpdateVersion), ctx, userID, repositoryID, pattern, version)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVersion", reflect.TypeOf((*KetchupService)(nil).UpdateVersion), ctx, userID, repositoryID, pattern, version)
+}
+
+// UpdateYankNotifiedVersion mocks base method.
+func (m *KetchupService) UpdateYankNotifiedVersion(ctx context.Context, item model0.Ketchup, version string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateYankNotifiedVersion", ctx, item, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateYankNotifiedVersion indicates an expected call of UpdateYankNotifiedVersion.
+func (mr *KetchupServiceMockRecorder) UpdateYankNotifiedVersion(ctx, item, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateYankNotifiedVersion", reflect.TypeOf((*KetchupService)(nil).UpdateYankNotifiedVersion), ctx, item, version)
+}
+
+// KetchupStore is a mock of KetchupStore interface.
+type KetchupStore struct {
+	ctrl     *gomock.Controller
+	recorder *KetchupStoreMockRecorder
+	isgomock struct{}
+}
+
+// KetchupStoreMockRecorder is the mock recorder for KetchupStore.
+type KetchupStoreMockRecorder struct {
+	mock *KetchupStore
+}
+
+// NewKetchupStore creates a new mock instance.
+func NewKetchupStore(ctrl *gomock.Controller) *KetchupStore {
+	mock := &KetchupStore{ctrl: ctrl}
+	mock.recorder = &KetchupStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *KetchupStore) EXPECT() *KetchupStoreMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *KetchupStore) Create(ctx context.Context, o model0.Ketchup) (model0.Identifier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, o)
+	ret0, _ := ret[0].(model0.Identifier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *KetchupStoreMockRecorder) Create(ctx, o any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*KetchupStore)(nil).Create), ctx, o)
+}
+
+// Delete mocks base method.
+func (m *KetchupStore) Delete(ctx context.Context, o model0.Ketchup) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, o)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *KetchupStoreMockRecorder) Delete(ctx, o any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*KetchupStore)(nil).Delete), ctx, o)
+}
+
+// DoAtomic mocks base method.
+func (m *KetchupStore) DoAtomic(ctx context.Context, action func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DoAtomic", ctx, action)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DoAtomic indicates an expected call of DoAtomic.
+func (mr *KetchupStoreMockRecorder) DoAtomic(ctx, action any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DoAtomic", reflect.TypeOf((*KetchupStore)(nil).DoAtomic), ctx, action)
+}
+
+// GetByRepository mocks base method.
+func (m *KetchupStore) GetByRepository(ctx context.Context, id model0.Identifier, pattern string, forUpdate bool) (model0.Ketchup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByRepository", ctx, id, pattern, forUpdate)
+	ret0, _ := ret[0].(model0.Ketchup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByRepository indicates an expected call of GetByRepository.
+func (mr *KetchupStoreMockRecorder) GetByRepository(ctx, id, pattern, forUpdate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByRepository", reflect.TypeOf((*KetchupStore)(nil).GetByRepository), ctx, id, pattern, forUpdate)
+}
+
+// List mocks base method.
+func (m *KetchupStore) List(ctx context.Context, page uint, last string) ([]model0.Ketchup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, page, last)
+	ret0, _ := ret[0].([]model0.Ketchup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *KetchupStoreMockRecorder) List(ctx, page, last any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*KetchupStore)(nil).List), ctx, page, last)
+}
+
+// ListByRepositoriesIDAndFrequencies mocks base method.
+func (m *KetchupStore) ListByRepositoriesIDAndFrequencies(ctx context.Context, ids []model0.Identifier, frequencies ...model0.KetchupFrequency) ([]model0.Ketchup, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, ids}
+	for _, a := range frequencies {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListByRepositoriesIDAndFrequencies", varargs...)
+	ret0, _ := ret[0].([]model0.Ketchup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByRepositoriesIDAndFrequencies indicates an expected call of ListByRepositoriesIDAndFrequencies.
+func (mr *KetchupStoreMockRecorder) ListByRepositoriesIDAndFrequencies(ctx, ids any, frequencies ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, ids}, frequencies...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByRepositoriesIDAndFrequencies", reflect.TypeOf((*KetchupStore)(nil).ListByRepositoriesIDAndFrequencies), varargs...)
+}
+
+// ListMatured mocks base method.
+func (m *KetchupStore) ListMatured(ctx context.Context, now time.Time, frequencies ...model0.KetchupFrequency) ([]model0.Ketchup, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, now}
+	for _, a := range frequencies {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListMatured", varargs...)
+	ret0, _ := ret[0].([]model0.Ketchup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMatured indicates an expected call of ListMatured.
+func (mr *KetchupStoreMockRecorder) ListMatured(ctx, now any, frequencies ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, now}, frequencies...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMatured", reflect.TypeOf((*KetchupStore)(nil).ListMatured), varargs...)
+}
+
+// ListOutdated mocks base method.
+func (m *KetchupStore) ListOutdated(ctx context.Context, usersIds ...model0.Identifier) ([]model0.Ketchup, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range usersIds {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListOutdated", varargs...)
+	ret0, _ := ret[0].([]model0.Ketchup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOutdated indicates an expected call of ListOutdated.
+func (mr *KetchupStoreMockRecorder) ListOutdated(ctx any, usersIds ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, usersIds...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOutdated", reflect.TypeOf((*KetchupStore)(nil).ListOutdated), varargs...)
+}
+
+// SkipVersion mocks base method.
+func (m *KetchupStore) SkipVersion(ctx context.Context, userID, repositoryID model0.Identifier, pattern, version string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SkipVersion", ctx, userID, repositoryID, pattern, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SkipVersion indicates an expected call of SkipVersion.
+func (mr *KetchupStoreMockRecorder) SkipVersion(ctx, userID, repositoryID, pattern, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SkipVersion", reflect.TypeOf((*KetchupStore)(nil).SkipVersion), ctx, userID, repositoryID, pattern, version)
+}
+
+// Snooze mocks base method.
+func (m *KetchupStore) Snooze(ctx context.Context, userID, repositoryID model0.Identifier, pattern string, until time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Snooze", ctx, userID, repositoryID, pattern, until)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Snooze indicates an expected call of Snooze.
+func (mr *KetchupStoreMockRecorder) Snooze(ctx, userID, repositoryID, pattern, until any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Snooze", reflect.TypeOf((*KetchupStore)(nil).Snooze), ctx, userID, repositoryID, pattern, until)
+}
+
+// Update mocks base method.
+func (m *KetchupStore) Update(ctx context.Context, o model0.Ketchup, oldPattern string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, o, oldPattern)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *KetchupStoreMockRecorder) Update(ctx, o, oldPattern any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*KetchupStore)(nil).Update), ctx, o, oldPattern)
+}
+
+// UpdateAll mocks base method.
+func (m *KetchupStore) UpdateAll(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAll", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAll indicates an expected call of UpdateAll.
+func (mr *KetchupStoreMockRecorder) UpdateAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAll", reflect.TypeOf((*KetchupStore)(nil).UpdateAll), ctx)
+}
+
+// UpdateFrequency mocks base method.
+func (m *KetchupStore) UpdateFrequency(ctx context.Context, userID, repositoryID model0.Identifier, pattern string, frequency model0.KetchupFrequency) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFrequency", ctx, userID, repositoryID, pattern, frequency)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFrequency indicates an expected call of UpdateFrequency.
+func (mr *KetchupStoreMockRecorder) UpdateFrequency(ctx, userID, repositoryID, pattern, frequency any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFrequency", reflect.TypeOf((*KetchupStore)(nil).UpdateFrequency), ctx, userID, repositoryID, pattern, frequency)
+}
+
+// UpdateNotifiedVersion mocks base method.
+func (m *KetchupStore) UpdateNotifiedVersion(ctx context.Context, o model0.Ketchup, version string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNotifiedVersion", ctx, o, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateNotifiedVersion indicates an expected call of UpdateNotifiedVersion.
+func (mr *KetchupStoreMockRecorder) UpdateNotifiedVersion(ctx, o, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNotifiedVersion", reflect.TypeOf((*KetchupStore)(nil).UpdateNotifiedVersion), ctx, o, version)
+}
+
+// UpdateRemindedAt mocks base method.
+func (m *KetchupStore) UpdateRemindedAt(ctx context.Context, o model0.Ketchup, remindedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRemindedAt", ctx, o, remindedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRemindedAt indicates an expected call of UpdateRemindedAt.
+func (mr *KetchupStoreMockRecorder) UpdateRemindedAt(ctx, o, remindedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRemindedAt", reflect.TypeOf((*KetchupStore)(nil).UpdateRemindedAt), ctx, o, remindedAt)
+}
+
+// UpdateVersion mocks base method.
+func (m *KetchupStore) UpdateVersion(ctx context.Context, userID, repositoryID model0.Identifier, pattern, version string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVersion", ctx, userID, repositoryID, pattern, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateVersion indicates an expected call of UpdateVersion.
+func (mr *KetchupStoreMockRecorder) UpdateVersion(ctx, userID, repositoryID, pattern, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVersion", reflect.TypeOf((*KetchupStore)(nil).UpdateVersion), ctx, userID, repositoryID, pattern, version)
+}
+
+// UpdateYankNotifiedVersion mocks base method.
+func (m *KetchupStore) UpdateYankNotifiedVersion(ctx context.Context, o model0.Ketchup, version string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateYankNotifiedVersion", ctx, o, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateYankNotifiedVersion indicates an expected call of UpdateYankNotifiedVersion.
+func (mr *KetchupStoreMockRecorder) UpdateYankNotifiedVersion(ctx, o, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateYankNotifiedVersion", reflect.TypeOf((*KetchupStore)(nil).UpdateYankNotifiedVersion), ctx, o, version)
+}
+
+// Notifier is a mock of Notifier interface.
+type Notifier struct {
+	ctrl     *gomock.Controller
+	recorder *NotifierMockRecorder
+	isgomock struct{}
+}
+
+// NotifierMockRecorder is the mock recorder for Notifier.
+type NotifierMockRecorder struct {
+	mock *Notifier
+}
+
+// NewNotifier creates a new mock instance.
+func NewNotifier(ctrl *gomock.Controller) *Notifier {
+	mock := &Notifier{ctrl: ctrl}
+	mock.recorder = &NotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Notifier) EXPECT() *NotifierMockRecorder {
+	return m.recorder
+}
+
+// Preview mocks base method.
+func (m *Notifier) Preview(channel model0.Channel, releases []model0.Release) ([]model0.Preview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Preview", channel, releases)
+	ret0, _ := ret[0].([]model0.Preview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Preview indicates an expected call of Preview.
+func (mr *NotifierMockRecorder) Preview(channel, releases any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Preview", reflect.TypeOf((*Notifier)(nil).Preview), channel, releases)
+}
+
+// Send mocks base method.
+func (m *Notifier) Send(ctx context.Context, channel model0.Channel, releases []model0.Release) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, channel, releases)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *NotifierMockRecorder) Send(ctx, channel, releases any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*Notifier)(nil).Send), ctx, channel, releases)
+}
+
+// ChannelService is a mock of ChannelService interface.
+type ChannelService struct {
+	ctrl     *gomock.Controller
+	recorder *ChannelServiceMockRecorder
+	isgomock struct{}
+}
+
+// ChannelServiceMockRecorder is the mock recorder for ChannelService.
+type ChannelServiceMockRecorder struct {
+	mock *ChannelService
+}
+
+// NewChannelService creates a new mock instance.
+func NewChannelService(ctrl *gomock.Controller) *ChannelService {
+	mock := &ChannelService{ctrl: ctrl}
+	mock.recorder = &ChannelServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *ChannelService) EXPECT() *ChannelServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *ChannelService) Create(ctx context.Context, item model0.Channel) (model0.Channel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, item)
+	ret0, _ := ret[0].(model0.Channel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *ChannelServiceMockRecorder) Create(ctx, item any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*ChannelService)(nil).Create), ctx, item)
+}
+
+// Delete mocks base method.
+func (m *ChannelService) Delete(ctx context.Context, item model0.Channel) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, item)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *ChannelServiceMockRecorder) Delete(ctx, item any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*ChannelService)(nil).Delete), ctx, item)
+}
+
+// List mocks base method.
+func (m *ChannelService) List(ctx context.Context) ([]model0.Channel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]model0.Channel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *ChannelServiceMockRecorder) List(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*ChannelService)(nil).List), ctx)
+}
+
+// ListForUser mocks base method.
+func (m *ChannelService) ListForUser(ctx context.Context, user model0.User) ([]model0.Channel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListForUser", ctx, user)
+	ret0, _ := ret[0].([]model0.Channel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListForUser indicates an expected call of ListForUser.
+func (mr *ChannelServiceMockRecorder) ListForUser(ctx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListForUser", reflect.TypeOf((*ChannelService)(nil).ListForUser), ctx, user)
+}
+
+// ChannelStore is a mock of ChannelStore interface.
+type ChannelStore struct {
+	ctrl     *gomock.Controller
+	recorder *ChannelStoreMockRecorder
+	isgomock struct{}
+}
+
+// ChannelStoreMockRecorder is the mock recorder for ChannelStore.
+type ChannelStoreMockRecorder struct {
+	mock *ChannelStore
+}
+
+// NewChannelStore creates a new mock instance.
+func NewChannelStore(ctrl *gomock.Controller) *ChannelStore {
+	mock := &ChannelStore{ctrl: ctrl}
+	mock.recorder = &ChannelStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *ChannelStore) EXPECT() *ChannelStoreMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *ChannelStore) Create(ctx context.Context, o model0.Channel) (model0.Identifier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, o)
+	ret0, _ := ret[0].(model0.Identifier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *ChannelStoreMockRecorder) Create(ctx, o any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*ChannelStore)(nil).Create), ctx, o)
+}
+
+// Delete mocks base method.
+func (m *ChannelStore) Delete(ctx context.Context, o model0.Channel) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, o)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *ChannelStoreMockRecorder) Delete(ctx, o any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*ChannelStore)(nil).Delete), ctx, o)
+}
+
+// ListByUser mocks base method.
+func (m *ChannelStore) ListByUser(ctx context.Context, userID model0.Identifier) ([]model0.Channel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByUser", ctx, userID)
+	ret0, _ := ret[0].([]model0.Channel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByUser indicates an expected call of ListByUser.
+func (mr *ChannelStoreMockRecorder) ListByUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUser", reflect.TypeOf((*ChannelStore)(nil).ListByUser), ctx, userID)
+}
+
+// WebhookStore is a mock of WebhookStore interface.
+type WebhookStore struct {
+	ctrl     *gomock.Controller
+	recorder *WebhookStoreMockRecorder
+	isgomock struct{}
+}
+
+// WebhookStoreMockRecorder is the mock recorder for WebhookStore.
+type WebhookStoreMockRecorder struct {
+	mock *WebhookStore
+}
+
+// NewWebhookStore creates a new mock instance.
+func NewWebhookStore(ctrl *gomock.Controller) *WebhookStore {
+	mock := &WebhookStore{ctrl: ctrl}
+	mock.recorder = &WebhookStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *WebhookStore) EXPECT() *WebhookStoreMockRecorder {
+	return m.recorder
+}
+
+// CreateDelivery mocks base method.
+func (m *WebhookStore) CreateDelivery(ctx context.Context, o model0.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDelivery", ctx, o)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateDelivery indicates an expected call of CreateDelivery.
+func (mr *WebhookStoreMockRecorder) CreateDelivery(ctx, o any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDelivery", reflect.TypeOf((*WebhookStore)(nil).CreateDelivery), ctx, o)
+}
+
+// NotificationService is a mock of NotificationService interface.
+type NotificationService struct {
+	ctrl     *gomock.Controller
+	recorder *NotificationServiceMockRecorder
+	isgomock struct{}
+}
+
+// NotificationServiceMockRecorder is the mock recorder for NotificationService.
+type NotificationServiceMockRecorder struct {
+	mock *NotificationService
+}
+
+// NewNotificationService creates a new mock instance.
+func NewNotificationService(ctrl *gomock.Controller) *NotificationService {
+	mock := &NotificationService{ctrl: ctrl}
+	mock.recorder = &NotificationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *NotificationService) EXPECT() *NotificationServiceMockRecorder {
+	return m.recorder
+}
+
+// DoAtomic mocks base method.
+func (m *NotificationService) DoAtomic(ctx context.Context, action func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DoAtomic", ctx, action)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DoAtomic indicates an expected call of DoAtomic.
+func (mr *NotificationServiceMockRecorder) DoAtomic(ctx, action any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DoAtomic", reflect.TypeOf((*NotificationService)(nil).DoAtomic), ctx, action)
+}
+
+// ListDue mocks base method.
+func (m *NotificationService) ListDue(ctx context.Context, now time.Time) ([]model0.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDue", ctx, now)
+	ret0, _ := ret[0].([]model0.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDue indicates an expected call of ListDue.
+func (mr *NotificationServiceMockRecorder) ListDue(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDue", reflect.TypeOf((*NotificationService)(nil).ListDue), ctx, now)
+}
+
+// Queue mocks base method.
+func (m *NotificationService) Queue(ctx context.Context, o model0.Notification) (model0.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Queue", ctx, o)
+	ret0, _ := ret[0].(model0.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Queue indicates an expected call of Queue.
+func (mr *NotificationServiceMockRecorder) Queue(ctx, o any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Queue", reflect.TypeOf((*NotificationService)(nil).Queue), ctx, o)
+}
+
+// Update mocks base method.
+func (m *NotificationService) Update(ctx context.Context, o model0.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, o)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *NotificationServiceMockRecorder) Update(ctx, o any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*NotificationService)(nil).Update), ctx, o)
+}
+
+// NotificationStore is a mock of NotificationStore interface.
+type NotificationStore struct {
+	ctrl     *gomock.Controller
+	recorder *NotificationStoreMockRecorder
+	isgomock struct{}
+}
+
+// NotificationStoreMockRecorder is the mock recorder for NotificationStore.
+type NotificationStoreMockRecorder struct {
+	mock *NotificationStore
+}
+
+// NewNotificationStore creates a new mock instance.
+func NewNotificationStore(ctrl *gomock.Controller) *NotificationStore {
+	mock := &NotificationStore{ctrl: ctrl}
+	mock.recorder = &NotificationStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *NotificationStore) EXPECT() *NotificationStoreMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *NotificationStore) Create(ctx context.Context, o model0.Notification) (model0.Identifier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, o)
+	ret0, _ := ret[0].(model0.Identifier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *NotificationStoreMockRecorder) Create(ctx, o any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*NotificationStore)(nil).Create), ctx, o)
+}
+
+// DoAtomic mocks base method.
+func (m *NotificationStore) DoAtomic(ctx context.Context, action func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DoAtomic", ctx, action)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DoAtomic indicates an expected call of DoAtomic.
+func (mr *NotificationStoreMockRecorder) DoAtomic(ctx, action any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DoAtomic", reflect.TypeOf((*NotificationStore)(nil).DoAtomic), ctx, action)
+}
+
+// GetPending mocks base method.
+func (m *NotificationStore) GetPending(ctx context.Context, o model0.Notification) (model0.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPending", ctx, o)
+	ret0, _ := ret[0].(model0.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPending indicates an expected call of GetPending.
+func (mr *NotificationStoreMockRecorder) GetPending(ctx, o any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPending", reflect.TypeOf((*NotificationStore)(nil).GetPending), ctx, o)
+}
+
+// ListDue mocks base method.
+func (m *NotificationStore) ListDue(ctx context.Context, now time.Time, maxAttempts uint) ([]model0.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDue", ctx, now, maxAttempts)
+	ret0, _ := ret[0].([]model0.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDue indicates an expected call of ListDue.
+func (mr *NotificationStoreMockRecorder) ListDue(ctx, now, maxAttempts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDue", reflect.TypeOf((*NotificationStore)(nil).ListDue), ctx, now, maxAttempts)
+}
+
+// Update mocks base method.
+func (m *NotificationStore) Update(ctx context.Context, o model0.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, o)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *NotificationStoreMockRecorder) Update(ctx, o any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*NotificationStore)(nil).Update), ctx, o)
+}
+
+// UpdateReleases mocks base method.
+func (m *NotificationStore) UpdateReleases(ctx context.Context, o model0.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReleases", ctx, o)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateReleases indicates an expected call of UpdateReleases.
+func (mr *NotificationStoreMockRecorder) UpdateReleases(ctx, o any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReleases", reflect.TypeOf((*NotificationStore)(nil).UpdateReleases), ctx, o)
+}
+
+// ReportService is a mock of ReportService interface.
+type ReportService struct {
+	ctrl     *gomock.Controller
+	recorder *ReportServiceMockRecorder
+	isgomock struct{}
+}
+
+// ReportServiceMockRecorder is the mock recorder for ReportService.
+type ReportServiceMockRecorder struct {
+	mock *ReportService
+}
+
+// NewReportService creates a new mock instance.
+func NewReportService(ctrl *gomock.Controller) *ReportService {
+	mock := &ReportService{ctrl: ctrl}
+	mock.recorder = &ReportServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *ReportService) EXPECT() *ReportServiceMockRecorder {
+	return m.recorder
+}
+
+// ListLatest mocks base method.
+func (m *ReportService) ListLatest(ctx context.Context) ([]model0.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLatest", ctx)
+	ret0, _ := ret[0].([]model0.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLatest indicates an expected call of ListLatest.
+func (mr *ReportServiceMockRecorder) ListLatest(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLatest", reflect.TypeOf((*ReportService)(nil).ListLatest), ctx)
+}
+
+// Save mocks base method.
+func (m *ReportService) Save(ctx context.Context, item model0.Report) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, item)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *ReportServiceMockRecorder) Save(ctx, item any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*ReportService)(nil).Save), ctx, item)
+}
+
+// ReportStore is a mock of ReportStore interface.
+type ReportStore struct {
+	ctrl     *gomock.Controller
+	recorder *ReportStoreMockRecorder
+	isgomock struct{}
+}
+
+// ReportStoreMockRecorder is the mock recorder for ReportStore.
+type ReportStoreMockRecorder struct {
+	mock *ReportStore
+}
+
+// NewReportStore creates a new mock instance.
+func NewReportStore(ctrl *gomock.Controller) *ReportStore {
+	mock := &ReportStore{ctrl: ctrl}
+	mock.recorder = &ReportStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *ReportStore) EXPECT() *ReportStoreMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *ReportStore) Create(ctx context.Context, o model0.Report) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, o)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *ReportStoreMockRecorder) Create(ctx, o any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*ReportStore)(nil).Create), ctx, o)
+}
+
+// DeleteBefore mocks base method.
+func (m *ReportStore) DeleteBefore(ctx context.Context, date time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBefore", ctx, date)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBefore indicates an expected call of DeleteBefore.
+func (mr *ReportStoreMockRecorder) DeleteBefore(ctx, date any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBefore", reflect.TypeOf((*ReportStore)(nil).DeleteBefore), ctx, date)
+}
+
+// ListLatest mocks base method.
+func (m *ReportStore) ListLatest(ctx context.Context) ([]model0.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLatest", ctx)
+	ret0, _ := ret[0].([]model0.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLatest indicates an expected call of ListLatest.
+func (mr *ReportStoreMockRecorder) ListLatest(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLatest", reflect.TypeOf((*ReportStore)(nil).ListLatest), ctx)
+}
+
+// Checker is a mock of Checker interface.
+type Checker struct {
+	ctrl     *gomock.Controller
+	recorder *CheckerMockRecorder
+	isgomock struct{}
+}
+
+// CheckerMockRecorder is the mock recorder for Checker.
+type CheckerMockRecorder struct {
+	mock *Checker
+}
+
+// NewChecker creates a new mock instance.
+func NewChecker(ctrl *gomock.Controller) *Checker {
+	mock := &Checker{ctrl: ctrl}
+	mock.recorder = &CheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Checker) EXPECT() *CheckerMockRecorder {
+	return m.recorder
+}
+
+// Background mocks base method.
+func (m *Checker) Background() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Background")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Background indicates an expected call of Background.
+func (mr *CheckerMockRecorder) Background() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Background", reflect.TypeOf((*Checker)(nil).Background))
+}
+
+// CheckAll mocks base method.
+func (m *Checker) CheckAll(ctx context.Context) (map[model0.RepositoryKind]model0.CheckReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckAll", ctx)
+	ret0, _ := ret[0].(map[model0.RepositoryKind]model0.CheckReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckAll indicates an expected call of CheckAll.
+func (mr *CheckerMockRecorder) CheckAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAll", reflect.TypeOf((*Checker)(nil).CheckAll), ctx)
+}
+
+// Preview mocks base method.
+func (m *Checker) Preview(ctx context.Context) (map[model0.RepositoryKind]model0.CheckReport, []model0.Release, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Preview", ctx)
+	ret0, _ := ret[0].(map[model0.RepositoryKind]model0.CheckReport)
+	ret1, _ := ret[1].([]model0.Release)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Preview indicates an expected call of Preview.
+func (mr *CheckerMockRecorder) Preview(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Preview", reflect.TypeOf((*Checker)(nil).Preview), ctx)
 }
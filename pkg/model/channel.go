@@ -0,0 +1,87 @@
+package model
+
+import (
+	"errors"
+	"slices"
+	"strings"
+)
+
+//go:generate stringer -type=ChannelKind
+type ChannelKind int
+
+const (
+	Email ChannelKind = iota
+	Webhook
+	Slack
+	Discord
+	Ntfy
+	Gotify
+)
+
+var ErrUnknownChannelKind = errors.New("unknown channel kind")
+
+func ParseChannelKind(value string) (ChannelKind, error) {
+	var previous, current uint8
+
+	for i := 1; i < len(_ChannelKind_index); i++ {
+		current = _ChannelKind_index[i]
+
+		if strings.EqualFold(_ChannelKind_name[previous:current], value) {
+			return ChannelKind(i - 1), nil
+		}
+
+		previous = current
+	}
+
+	return Email, ErrUnknownChannelKind
+}
+
+type Channel struct {
+	URL         string
+	Secret      string
+	User        User
+	Frequencies []KetchupFrequency
+	ID          Identifier
+	Kind        ChannelKind
+}
+
+// NewEmailChannel is the channel used for users that haven't configured any
+func NewEmailChannel(user User) Channel {
+	return Channel{
+		Kind:        Email,
+		User:        user,
+		Frequencies: []KetchupFrequency{Daily, Weekly, Monthly, Immediate},
+	}
+}
+
+func (c Channel) IsZero() bool {
+	return c.ID.IsZero()
+}
+
+func (c Channel) Accepts(frequency KetchupFrequency) bool {
+	return slices.Contains(c.Frequencies, frequency)
+}
+
+// Filter keeps the releases whose ketchup frequency is routed to the channel
+func (c Channel) Filter(releases []Release) []Release {
+	var output []Release
+
+	for _, release := range releases {
+		if c.Accepts(release.Frequency) {
+			output = append(output, release)
+		}
+	}
+
+	return output
+}
+
+type WebhookDelivery struct {
+	URL        string
+	Error      string
+	UserID     Identifier
+	StatusCode int
+}
+
+func (w WebhookDelivery) Succeeded() bool {
+	return len(w.Error) == 0
+}
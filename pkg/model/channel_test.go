@@ -0,0 +1,128 @@
+package model
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseChannelKind(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		value string
+	}
+
+	cases := map[string]struct {
+		args    args
+		want    ChannelKind
+		wantErr error
+	}{
+		"UpperCase": {
+			args{
+				value: "SLACK",
+			},
+			Slack,
+			nil,
+		},
+		"not found": {
+			args{
+				value: "teams",
+			},
+			Email,
+			ErrUnknownChannelKind,
+		},
+		"first bound": {
+			args{
+				value: "email",
+			},
+			Email,
+			nil,
+		},
+		"last bound": {
+			args{
+				value: "Gotify",
+			},
+			Gotify,
+			nil,
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotErr := ParseChannelKind(testCase.args.value)
+
+			failed := false
+
+			if testCase.wantErr == nil && gotErr != nil {
+				failed = true
+			} else if testCase.wantErr != nil && gotErr == nil {
+				failed = true
+			} else if testCase.wantErr != nil && !strings.Contains(gotErr.Error(), testCase.wantErr.Error()) {
+				failed = true
+			} else if got != testCase.want {
+				failed = true
+			}
+
+			if failed {
+				t.Errorf("ParseChannelKind() = (`%s`, `%s`), want (`%s`, `%s`)", got, gotErr, testCase.want, testCase.wantErr)
+			}
+		})
+	}
+}
+
+func TestFilter(t *testing.T) {
+	t.Parallel()
+
+	daily := Release{Pattern: "stable", Frequency: Daily}
+	weekly := Release{Pattern: "^1", Frequency: Weekly}
+
+	type args struct {
+		releases []Release
+	}
+
+	cases := map[string]struct {
+		instance Channel
+		args     args
+		want     []Release
+	}{
+		"empty": {
+			Channel{Frequencies: []KetchupFrequency{Daily}},
+			args{},
+			nil,
+		},
+		"all": {
+			NewEmailChannel(User{}),
+			args{
+				releases: []Release{daily, weekly},
+			},
+			[]Release{daily, weekly},
+		},
+		"weekly only": {
+			Channel{Frequencies: []KetchupFrequency{Weekly}},
+			args{
+				releases: []Release{daily, weekly},
+			},
+			[]Release{weekly},
+		},
+		"none": {
+			Channel{},
+			args{
+				releases: []Release{daily, weekly},
+			},
+			nil,
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCase.instance.Filter(testCase.args.releases); !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("Filter() = %+v, want %+v", got, testCase.want)
+			}
+		})
+	}
+}
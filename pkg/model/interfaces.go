@@ -2,6 +2,7 @@ package model
 
 import (
 	"context"
+	"time"
 
 	authModel "github.com/ViBiOh/auth/v3/pkg/model"
 	"github.com/ViBiOh/ketchup/pkg/semver"
@@ -14,7 +15,7 @@ func (i Identifier) IsZero() bool {
 	return i == 0
 }
 
-//go:generate mockgen -source $GOFILE -destination ../mocks/$GOFILE -package mocks -mock_names Mailer=Mailer,AuthService=AuthService,UserService=UserService,UserStore=UserStore,GenericProvider=GenericProvider,HelmProvider=HelmProvider,RepositoryService=RepositoryService,RepositoryStore=RepositoryStore,KetchupService=KetchupService,KetchupStore=KetchupStore
+//go:generate mockgen -source $GOFILE -destination ../mocks/$GOFILE -package mocks -mock_names Mailer=Mailer,AuthService=AuthService,UserService=UserService,UserStore=UserStore,Redis=Redis,GenericProvider=GenericProvider,HelmProvider=HelmProvider,YankProvider=YankProvider,DetailProvider=DetailProvider,RepositoryService=RepositoryService,RepositoryStore=RepositoryStore,ReleaseService=ReleaseService,ReleaseStore=ReleaseStore,KetchupService=KetchupService,KetchupStore=KetchupStore,Notifier=Notifier,ChannelService=ChannelService,ChannelStore=ChannelStore,WebhookStore=WebhookStore,NotificationService=NotificationService,NotificationStore=NotificationStore,ReportService=ReportService,ReportStore=ReportStore,Checker=Checker
 
 type Mailer interface {
 	Enabled() bool
@@ -33,31 +34,52 @@ type UserStore interface {
 	DoAtomic(context.Context, func(context.Context) error) error
 	GetByLoginID(context.Context, string) (User, error)
 	GetByEmail(context.Context, string) (User, error)
+	GetByFeedToken(context.Context, string) (User, error)
+	GetFeedToken(context.Context, Identifier) (string, error)
+	UpdateFeedToken(context.Context, Identifier, string) error
+	UpdateSettings(context.Context, User) error
 	Create(context.Context, User) (Identifier, error)
 	Count(context.Context) (uint64, error)
 }
 
+// Redis is the distributed lock shared by the instances, for running a task on only one of them
+type Redis interface {
+	Exclusive(context.Context, string, time.Duration, func(context.Context) error) (bool, error)
+}
+
 type GenericProvider interface {
 	LatestVersions(context.Context, string, []string) (map[string]semver.Version, error)
 }
 
+type YankProvider interface {
+	GenericProvider
+	Versions(context.Context, string, []string) (map[string]semver.Version, []string, error)
+}
+
+type DetailProvider interface {
+	GenericProvider
+	ReleaseDetail(context.Context, string, string) (ReleaseDetail, error)
+}
+
 type HelmProvider interface {
 	FetchIndex(context.Context, string, map[string][]string) (map[string]map[string]semver.Version, error)
 	LatestVersions(context.Context, string, string, []string) (map[string]semver.Version, error)
 }
 
 type RepositoryService interface {
-	List(context.Context, uint, Identifier) ([]Repository, error)
+	List(context.Context, uint, Identifier, ...RepositoryKind) ([]Repository, error)
 	Suggest(context.Context, []Identifier, uint64) ([]Repository, error)
 	GetOrCreate(context.Context, RepositoryKind, string, string, string) (Repository, error)
 	Update(context.Context, Repository) error
 	Clean(context.Context) error
 	LatestVersions(context.Context, Repository) (map[string]semver.Version, error)
+	Versions(context.Context, Repository) (map[string]semver.Version, []string, error)
+	ReleaseDetail(context.Context, Repository, string) (ReleaseDetail, error)
 }
 
 type RepositoryStore interface {
 	DoAtomic(ctx context.Context, action func(context.Context) error) error
-	List(ctx context.Context, pageSize uint, last Identifier) ([]Repository, error)
+	List(ctx context.Context, pageSize uint, last Identifier, kinds ...RepositoryKind) ([]Repository, error)
 	Suggest(ctx context.Context, ignoreIds []Identifier, count uint64) ([]Repository, error)
 	Get(ctx context.Context, id Identifier, forUpdate bool) (Repository, error)
 	GetByName(ctx context.Context, repositoryKind RepositoryKind, name, part string) (Repository, error)
@@ -67,14 +89,40 @@ type RepositoryStore interface {
 	DeleteUnusedVersions(ctx context.Context) error
 }
 
+type ReleaseService interface {
+	DoAtomic(ctx context.Context, action func(context.Context) error) error
+	Record(ctx context.Context, releases []Release) error
+	ListForUser(ctx context.Context, user User, count uint) ([]Release, error)
+	ListPending(ctx context.Context) ([]Release, error)
+	ListYanked(ctx context.Context) ([]Release, error)
+	MarkNotified(ctx context.Context, releases []Release) error
+}
+
+type ReleaseStore interface {
+	DoAtomic(ctx context.Context, action func(context.Context) error) error
+	Create(ctx context.Context, o Release) error
+	Yank(ctx context.Context, o Release) error
+	ListByUser(ctx context.Context, userID Identifier, count uint) ([]Release, error)
+	ListPending(ctx context.Context) ([]Release, error)
+	ListYanked(ctx context.Context) ([]Release, error)
+	MarkNotified(ctx context.Context, o Release) error
+}
+
 type KetchupService interface {
 	List(ctx context.Context, pageSize uint, last string) ([]Ketchup, error)
 	ListForRepositories(ctx context.Context, repositories []Repository, frequencies ...KetchupFrequency) ([]Ketchup, error)
 	ListOutdated(ctx context.Context, users ...User) ([]Ketchup, error)
+	ListMatured(ctx context.Context, now time.Time, frequencies ...KetchupFrequency) ([]Ketchup, error)
 	Create(ctx context.Context, item Ketchup) (Ketchup, error)
 	Update(ctx context.Context, oldPattern string, item Ketchup) (Ketchup, error)
 	UpdateAll(ctx context.Context) error
 	UpdateVersion(ctx context.Context, userID, repositoryID Identifier, pattern, version string) error
+	UpdateNotifiedVersion(ctx context.Context, item Ketchup, version string) error
+	UpdateYankNotifiedVersion(ctx context.Context, item Ketchup, version string) error
+	UpdateRemindedAt(ctx context.Context, item Ketchup, remindedAt time.Time) error
+	Snooze(ctx context.Context, userID, repositoryID Identifier, pattern string, until time.Time) error
+	SkipVersion(ctx context.Context, userID, repositoryID Identifier, pattern, version string) error
+	UpdateFrequency(ctx context.Context, userID, repositoryID Identifier, pattern string, frequency KetchupFrequency) error
 	Delete(ctx context.Context, item Ketchup) error
 }
 
@@ -83,10 +131,72 @@ type KetchupStore interface {
 	List(ctx context.Context, page uint, last string) ([]Ketchup, error)
 	ListByRepositoriesIDAndFrequencies(ctx context.Context, ids []Identifier, frequencies ...KetchupFrequency) ([]Ketchup, error)
 	ListOutdated(ctx context.Context, usersIds ...Identifier) ([]Ketchup, error)
+	ListMatured(ctx context.Context, now time.Time, frequencies ...KetchupFrequency) ([]Ketchup, error)
 	GetByRepository(ctx context.Context, id Identifier, pattern string, forUpdate bool) (Ketchup, error)
 	Create(ctx context.Context, o Ketchup) (Identifier, error)
 	Update(ctx context.Context, o Ketchup, oldPattern string) error
 	UpdateAll(ctx context.Context) error
 	UpdateVersion(ctx context.Context, userID, repositoryID Identifier, pattern, version string) error
+	UpdateNotifiedVersion(ctx context.Context, o Ketchup, version string) error
+	UpdateYankNotifiedVersion(ctx context.Context, o Ketchup, version string) error
+	UpdateRemindedAt(ctx context.Context, o Ketchup, remindedAt time.Time) error
+	Snooze(ctx context.Context, userID, repositoryID Identifier, pattern string, until time.Time) error
+	SkipVersion(ctx context.Context, userID, repositoryID Identifier, pattern, version string) error
+	UpdateFrequency(ctx context.Context, userID, repositoryID Identifier, pattern string, frequency KetchupFrequency) error
 	Delete(ctx context.Context, o Ketchup) error
 }
+
+type Notifier interface {
+	Send(ctx context.Context, channel Channel, releases []Release) error
+	Preview(channel Channel, releases []Release) ([]Preview, error)
+}
+
+type ChannelService interface {
+	List(ctx context.Context) ([]Channel, error)
+	ListForUser(ctx context.Context, user User) ([]Channel, error)
+	Create(ctx context.Context, item Channel) (Channel, error)
+	Delete(ctx context.Context, item Channel) error
+}
+
+type ChannelStore interface {
+	ListByUser(ctx context.Context, userID Identifier) ([]Channel, error)
+	Create(ctx context.Context, o Channel) (Identifier, error)
+	Delete(ctx context.Context, o Channel) error
+}
+
+type WebhookStore interface {
+	CreateDelivery(ctx context.Context, o WebhookDelivery) error
+}
+
+type NotificationService interface {
+	DoAtomic(ctx context.Context, action func(context.Context) error) error
+	Queue(ctx context.Context, o Notification) (Notification, error)
+	ListDue(ctx context.Context, now time.Time) ([]Notification, error)
+	Update(ctx context.Context, o Notification) error
+}
+
+type NotificationStore interface {
+	DoAtomic(ctx context.Context, action func(context.Context) error) error
+	Create(ctx context.Context, o Notification) (Identifier, error)
+	GetPending(ctx context.Context, o Notification) (Notification, error)
+	ListDue(ctx context.Context, now time.Time, maxAttempts uint) ([]Notification, error)
+	UpdateReleases(ctx context.Context, o Notification) error
+	Update(ctx context.Context, o Notification) error
+}
+
+type ReportService interface {
+	Save(ctx context.Context, item Report) error
+	ListLatest(ctx context.Context) ([]Report, error)
+}
+
+type ReportStore interface {
+	Create(ctx context.Context, o Report) error
+	DeleteBefore(ctx context.Context, date time.Time) error
+	ListLatest(ctx context.Context) ([]Report, error)
+}
+
+type Checker interface {
+	Background() bool
+	CheckAll(ctx context.Context) (map[RepositoryKind]CheckReport, error)
+	Preview(ctx context.Context) (map[RepositoryKind]CheckReport, []Release, error)
+}
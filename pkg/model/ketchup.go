@@ -3,6 +3,7 @@ package model
 import (
 	"errors"
 	"strings"
+	"time"
 
 	"github.com/ViBiOh/httputils/v4/pkg/hash"
 	"github.com/ViBiOh/ketchup/pkg/semver"
@@ -15,6 +16,8 @@ const (
 	None KetchupFrequency = iota
 	Daily
 	Weekly
+	Monthly
+	Immediate
 )
 
 var ErrUnknownKetchupFrequency = errors.New("unknown ketchup frequency")
@@ -35,15 +38,64 @@ func ParseKetchupFrequency(value string) (KetchupFrequency, error) {
 	return Daily, ErrUnknownKetchupFrequency
 }
 
+//go:generate stringer -type=KetchupSeverity -trimprefix=Severity
+type KetchupSeverity int
+
+// Severities are the minimum change level between the current and the new version that triggers a notification
+const (
+	SeverityAny KetchupSeverity = iota
+	SeverityMinor
+	SeverityMajor
+)
+
+var ErrUnknownKetchupSeverity = errors.New("unknown ketchup severity")
+
+func ParseKetchupSeverity(value string) (KetchupSeverity, error) {
+	var previous, current uint8
+
+	for i := 1; i < len(_KetchupSeverity_index); i++ {
+		current = _KetchupSeverity_index[i]
+
+		if strings.EqualFold(_KetchupSeverity_name[previous:current], value) {
+			return KetchupSeverity(i - 1), nil
+		}
+
+		previous = current
+	}
+
+	return SeverityAny, ErrUnknownKetchupSeverity
+}
+
+// Accepts checks if a change given by semver's Compare reaches the severity, an unknown change being always notified
+func (s KetchupSeverity) Accepts(change string) bool {
+	switch {
+	case len(change) == 0, s == SeverityAny:
+		return true
+	case s == SeverityMinor:
+		return semver.ChangePriority(change) <= semver.ChangePriority("Minor")
+	default:
+		return change == "Major"
+	}
+}
+
+// MaxCooldown is the longest number of days a ketchup can wait before being notified of a release
+const MaxCooldown = 90
+
 type Ketchup struct {
-	ID               string
-	Semver           string
-	Pattern          string
-	Version          string
-	User             User
-	Repository       Repository
-	Frequency        KetchupFrequency
-	UpdateWhenNotify bool
+	SnoozedUntil        time.Time
+	RemindedAt          time.Time
+	ID                  string
+	Semver              string
+	Pattern             string
+	Version             string
+	SkippedVersion      string
+	YankNotifiedVersion string
+	User                User
+	Repository          Repository
+	Frequency           KetchupFrequency
+	Severity            KetchupSeverity
+	Cooldown            uint
+	UpdateWhenNotify    bool
 }
 
 func NewKetchup(pattern, version string, frequency KetchupFrequency, updateWhenNotify bool, repo Repository) Ketchup {
@@ -56,6 +108,29 @@ func NewKetchup(pattern, version string, frequency KetchupFrequency, updateWhenN
 	}
 }
 
+// SetCooldown sets the number of days a release must have been public before being notified
+func (k Ketchup) SetCooldown(cooldown uint) Ketchup {
+	k.Cooldown = cooldown
+
+	return k
+}
+
+func (k Ketchup) SetSeverity(severity KetchupSeverity) Ketchup {
+	k.Severity = severity
+
+	return k
+}
+
+// IsSilenced checks if the user postponed the notifications of the given upstream version, by snoozing the ketchup or skipping that version
+func (k Ketchup) IsSilenced(version string, now time.Time) bool {
+	return now.Before(k.SnoozedUntil) || (len(k.SkippedVersion) != 0 && k.SkippedVersion == version)
+}
+
+// Notifies checks if the release is worth a notification given the severity of the ketchup
+func (k Ketchup) Notifies(release Release) bool {
+	return k.Severity.Accepts(release.SetCurrent(k.Version).Change())
+}
+
 func (k Ketchup) WithID() Ketchup {
 	k.ID = hash.Hash(k)[:8]
 
@@ -91,24 +166,27 @@ func (a KetchupByPriority) Less(i, j int) bool {
 		return first.Repository.Name < second.Repository.Name
 	}
 
-	if first.Semver != "" && second.Semver == "" {
-		return true
-	}
-
-	if first.Semver == "" && second.Semver != "" {
-		return false
-	}
-
-	return first.Semver < second.Semver
+	return semver.ChangePriority(first.Semver) < semver.ChangePriority(second.Semver)
 }
 func (a KetchupByPriority) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
 
 type Release struct {
-	Pattern    string         `json:"pattern"`
-	URL        string         `json:"url"`
-	Repository Repository     `json:"repository"`
-	Version    semver.Version `json:"version"`
-	Updated    uint           `json:"updated"`
+	DetectedAt  time.Time        `json:"detected_at,omitzero"`
+	PublishedAt time.Time        `json:"published_at,omitzero"`
+	Pattern     string           `json:"pattern"`
+	URL         string           `json:"url"`
+	Current     string           `json:"current"`
+	Notes       string           `json:"notes,omitempty"`
+	Repository  Repository       `json:"repository"`
+	Version     semver.Version   `json:"version"`
+	Updated     uint             `json:"updated"`
+	Frequency   KetchupFrequency `json:"-"`
+}
+
+// ReleaseDetail is the optional information a provider publishes alongside a version
+type ReleaseDetail struct {
+	PublishedAt time.Time
+	Notes       string
 }
 
 func NewRelease(repository Repository, pattern string, version semver.Version) Release {
@@ -120,12 +198,76 @@ func NewRelease(repository Repository, pattern string, version semver.Version) R
 	}
 }
 
+func (r Release) SetCurrent(version string) Release {
+	r.Current = version
+
+	return r
+}
+
+// Latest gives the version to upgrade to, the weekly reminder carrying the user's version in the release
+func (r Release) Latest() string {
+	if r.Version.Name == r.Current {
+		if latest := r.Repository.Versions[r.Pattern]; len(latest) != 0 {
+			return latest
+		}
+	}
+
+	return r.Version.Name
+}
+
+// Change gives the level of the upgrade from the user's version, as named by semver.Version.Compare
+func (r Release) Change() string {
+	if len(r.Current) == 0 {
+		return ""
+	}
+
+	current, err := r.Repository.ParseVersion(r.Current)
+	if err != nil {
+		return ""
+	}
+
+	latest := r.Version
+	if latestName := r.Latest(); latestName != latest.Name {
+		if latest, err = r.Repository.ParseVersion(latestName); err != nil {
+			return ""
+		}
+	}
+
+	return latest.Compare(current)
+}
+
+func (r Release) SetFrequency(frequency KetchupFrequency) Release {
+	r.Frequency = frequency
+
+	return r
+}
+
+func (r Release) SetDetail(detail ReleaseDetail) Release {
+	r.PublishedAt = detail.PublishedAt
+	r.Notes = detail.Notes
+
+	return r
+}
+
 func (r Release) SetUpdated(status uint) Release {
 	r.Updated = status
 
 	return r
 }
 
+// SplitYankedReleases separates the upstream releases from the versions withdrawn by their registry
+func SplitYankedReleases(releases []Release) (newReleases, yankedReleases []Release) {
+	for _, release := range releases {
+		if release.Version.IsYanked() {
+			yankedReleases = append(yankedReleases, release)
+		} else {
+			newReleases = append(newReleases, release)
+		}
+	}
+
+	return newReleases, yankedReleases
+}
+
 type ReleaseByRepositoryIDAndPattern []Release
 
 func (a ReleaseByRepositoryIDAndPattern) Len() int      { return len(a) }
@@ -5,6 +5,7 @@ import (
 	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/ViBiOh/ketchup/pkg/semver"
 )
@@ -35,6 +36,13 @@ func TestParseKetchupFrequency(t *testing.T) {
 			Weekly,
 			nil,
 		},
+		"last bound": {
+			args{
+				value: "immediate",
+			},
+			Immediate,
+			nil,
+		},
 		"not found": {
 			args{
 				value: "wrong",
@@ -140,6 +148,7 @@ func TestKetchupByPriority(t *testing.T) {
 				array: []Ketchup{
 					{Semver: "Minor", Repository: NewGithubRepository(Identifier(0), "abc")},
 					{Semver: "Major", Repository: NewGithubRepository(Identifier(0), "ghi")},
+					{Semver: "Build", Repository: NewGithubRepository(Identifier(0), "aaa")},
 					{Semver: "Patch", Repository: NewGithubRepository(Identifier(0), "jkl")},
 					{Semver: "", Repository: NewGithubRepository(Identifier(0), "def")},
 				},
@@ -148,6 +157,7 @@ func TestKetchupByPriority(t *testing.T) {
 				{Semver: "Major", Repository: NewGithubRepository(Identifier(0), "ghi")},
 				{Semver: "Minor", Repository: NewGithubRepository(Identifier(0), "abc")},
 				{Semver: "Patch", Repository: NewGithubRepository(Identifier(0), "jkl")},
+				{Semver: "Build", Repository: NewGithubRepository(Identifier(0), "aaa")},
 				{Semver: "", Repository: NewGithubRepository(Identifier(0), "def")},
 			},
 		},
@@ -266,3 +276,187 @@ func TestReleaseByKindAndName(t *testing.T) {
 		})
 	}
 }
+
+func TestReleaseChange(t *testing.T) {
+	t.Parallel()
+
+	repository := NewGithubRepository(Identifier(1), "vibioh/ketchup").AddVersion(DefaultPattern, "2.0.0")
+
+	cases := map[string]struct {
+		instance Release
+		want     string
+	}{
+		"no current": {
+			NewRelease(repository, DefaultPattern, safeParse("1.1.0")),
+			"",
+		},
+		"minor": {
+			NewRelease(repository, DefaultPattern, safeParse("1.1.0")).SetCurrent("1.0.0"),
+			"Minor",
+		},
+		"patch": {
+			NewRelease(repository, DefaultPattern, safeParse("1.0.1")).SetCurrent("1.0.0"),
+			"Patch",
+		},
+		"weekly reminder": {
+			NewRelease(repository, DefaultPattern, safeParse("1.0.0")).SetCurrent("1.0.0"),
+			"Major",
+		},
+		"invalid current": {
+			NewRelease(repository, DefaultPattern, safeParse("1.1.0")).SetCurrent("latest"),
+			"",
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCase.instance.Change(); got != testCase.want {
+				t.Errorf("Change() = `%s`, want `%s`", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestKetchupSeverityAccepts(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		instance KetchupSeverity
+		change   string
+		want     bool
+	}{
+		"any": {
+			SeverityAny,
+			"Patch",
+			true,
+		},
+		"minor with patch": {
+			SeverityMinor,
+			"Patch",
+			false,
+		},
+		"minor with minor": {
+			SeverityMinor,
+			"Minor",
+			true,
+		},
+		"minor with major": {
+			SeverityMinor,
+			"Major",
+			true,
+		},
+		"major with minor": {
+			SeverityMajor,
+			"Minor",
+			false,
+		},
+		"major with major": {
+			SeverityMajor,
+			"Major",
+			true,
+		},
+		"unknown change": {
+			SeverityMajor,
+			"",
+			true,
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCase.instance.Accepts(testCase.change); got != testCase.want {
+				t.Errorf("Accepts() = %t, want %t", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestNotifies(t *testing.T) {
+	t.Parallel()
+
+	repository := NewGithubRepository(Identifier(1), "vibioh/ketchup")
+
+	cases := map[string]struct {
+		instance Ketchup
+		release  Release
+		want     bool
+	}{
+		"any": {
+			NewKetchup(DefaultPattern, "1.0.0", Daily, false, repository),
+			NewRelease(repository, DefaultPattern, safeParse("1.0.1")),
+			true,
+		},
+		"major only with minor": {
+			NewKetchup(DefaultPattern, "1.0.0", Daily, false, repository).SetSeverity(SeverityMajor),
+			NewRelease(repository, DefaultPattern, safeParse("1.1.0")),
+			false,
+		},
+		"major only with major": {
+			NewKetchup(DefaultPattern, "1.0.0", Daily, false, repository).SetSeverity(SeverityMajor),
+			NewRelease(repository, DefaultPattern, safeParse("2.0.0")),
+			true,
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCase.instance.Notifies(testCase.release); got != testCase.want {
+				t.Errorf("Notifies() = %t, want %t", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestIsSilenced(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)
+
+	cases := map[string]struct {
+		instance Ketchup
+		version  string
+		want     bool
+	}{
+		"simple": {
+			Ketchup{},
+			"1.0.0",
+			false,
+		},
+		"snoozed": {
+			Ketchup{SnoozedUntil: now.Add(time.Hour)},
+			"1.0.0",
+			true,
+		},
+		"snooze expired": {
+			Ketchup{SnoozedUntil: now.Add(-time.Hour)},
+			"1.0.0",
+			false,
+		},
+		"skipped": {
+			Ketchup{SkippedVersion: "1.0.0"},
+			"1.0.0",
+			true,
+		},
+		"newer than skipped": {
+			Ketchup{SkippedVersion: "1.0.0"},
+			"1.0.1",
+			false,
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCase.instance.IsSilenced(testCase.version, now); got != testCase.want {
+				t.Errorf("IsSilenced() = %t, want %t", got, testCase.want)
+			}
+		})
+	}
+}
@@ -11,11 +11,13 @@ func _() {
 	_ = x[None-0]
 	_ = x[Daily-1]
 	_ = x[Weekly-2]
+	_ = x[Monthly-3]
+	_ = x[Immediate-4]
 }
 
-const _KetchupFrequency_name = "NoneDailyWeekly"
+const _KetchupFrequency_name = "NoneDailyWeeklyMonthlyImmediate"
 
-var _KetchupFrequency_index = [...]uint8{0, 4, 9, 15}
+var _KetchupFrequency_index = [...]uint8{0, 4, 9, 15, 22, 31}
 
 func (i KetchupFrequency) String() string {
 	if i < 0 || i >= KetchupFrequency(len(_KetchupFrequency_index)-1) {
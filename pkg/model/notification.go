@@ -0,0 +1,104 @@
+package model
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+)
+
+//go:generate stringer -type=NotificationStatus
+type NotificationStatus int
+
+const (
+	Pending NotificationStatus = iota
+	Delivered
+	Failed
+)
+
+// MaxNotificationAttempts is the number of runs trying to deliver a notification before giving up
+const MaxNotificationAttempts = 3
+
+var (
+	ErrUnknownNotificationStatus = errors.New("unknown notification status")
+
+	// ErrPermanent wraps a failure that retrying can't fix, for the notification not to be retried
+	ErrPermanent = errors.New("permanent failure")
+)
+
+func ParseNotificationStatus(value string) (NotificationStatus, error) {
+	var previous, current uint8
+
+	for i := 1; i < len(_NotificationStatus_index); i++ {
+		current = _NotificationStatus_index[i]
+
+		if strings.EqualFold(_NotificationStatus_name[previous:current], value) {
+			return NotificationStatus(i - 1), nil
+		}
+
+		previous = current
+	}
+
+	return Pending, ErrUnknownNotificationStatus
+}
+
+// Notification is the digest of releases sent to one channel of a user at its scheduled time, logged for resuming an interrupted run
+type Notification struct {
+	ScheduledAt time.Time
+	Error       string
+	Channel     Channel
+	Releases    []Release
+	ID          Identifier
+	Attempts    uint
+	Status      NotificationStatus
+}
+
+// Preview is a notification rendered as it would be sent, written in dry-run for reviewing it
+type Preview struct {
+	Format  string
+	Content []byte
+}
+
+func NewNotification(channel Channel, releases []Release, scheduledAt time.Time) Notification {
+	return Notification{
+		Channel:     channel,
+		Releases:    releases,
+		ScheduledAt: scheduledAt,
+		Status:      Pending,
+	}
+}
+
+// Merge adds the releases to the notification, the given ones replacing those of the same repository and pattern
+func (n Notification) Merge(releases []Release) Notification {
+	merged := make([]Release, 0, len(n.Releases)+len(releases))
+
+	for _, existing := range n.Releases {
+		if !slices.ContainsFunc(releases, func(release Release) bool {
+			return release.Repository.ID == existing.Repository.ID && release.Pattern == existing.Pattern
+		}) {
+			merged = append(merged, existing)
+		}
+	}
+
+	n.Releases = append(merged, releases...)
+
+	return n
+}
+
+func (n Notification) SetResult(err error) Notification {
+	n.Attempts++
+
+	if err != nil {
+		n.Status = Failed
+		n.Error = err.Error()
+
+		if errors.Is(err, ErrPermanent) {
+			n.Attempts = max(n.Attempts, MaxNotificationAttempts)
+		}
+	} else {
+		n.Status = Delivered
+		n.Error = ""
+	}
+
+	return n
+}
@@ -0,0 +1,93 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestMerge(t *testing.T) {
+	t.Parallel()
+
+	first := Repository{ID: 1}
+	second := Repository{ID: 2}
+
+	type args struct {
+		releases []Release
+	}
+
+	cases := map[string]struct {
+		instance Notification
+		args     args
+		want     []Release
+	}{
+		"empty": {
+			Notification{},
+			args{
+				releases: []Release{{Repository: first, Pattern: "stable"}},
+			},
+			[]Release{{Repository: first, Pattern: "stable"}},
+		},
+		"append": {
+			Notification{Releases: []Release{{Repository: first, Pattern: "stable"}}},
+			args{
+				releases: []Release{{Repository: second, Pattern: "stable"}},
+			},
+			[]Release{{Repository: first, Pattern: "stable"}, {Repository: second, Pattern: "stable"}},
+		},
+		"replace": {
+			Notification{Releases: []Release{{Repository: first, Pattern: "stable", Current: "1.0.0"}, {Repository: first, Pattern: "^2"}}},
+			args{
+				releases: []Release{{Repository: first, Pattern: "stable", Current: "1.1.0"}},
+			},
+			[]Release{{Repository: first, Pattern: "^2"}, {Repository: first, Pattern: "stable", Current: "1.1.0"}},
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCase.instance.Merge(testCase.args.releases); !reflect.DeepEqual(got.Releases, testCase.want) {
+				t.Errorf("Merge() = %+v, want %+v", got.Releases, testCase.want)
+			}
+		})
+	}
+}
+
+func TestSetResult(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		instance Notification
+		err      error
+		want     Notification
+	}{
+		"delivered": {
+			Notification{Attempts: 1, Status: Failed, Error: "failed"},
+			nil,
+			Notification{Attempts: 2, Status: Delivered},
+		},
+		"failed": {
+			Notification{},
+			errors.New("failed"),
+			Notification{Attempts: 1, Status: Failed, Error: "failed"},
+		},
+		"permanent": {
+			Notification{},
+			fmt.Errorf("unexpected status code 404: %w", ErrPermanent),
+			Notification{Attempts: MaxNotificationAttempts, Status: Failed, Error: "unexpected status code 404: permanent failure"},
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCase.instance.SetResult(testCase.err); !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("SetResult() = %+v, want %+v", got, testCase.want)
+			}
+		})
+	}
+}
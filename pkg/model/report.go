@@ -0,0 +1,86 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// NotifierReport is the name of the reports of the notifier runs, the checks being named after their repository kind
+const NotifierReport = "notifier"
+
+// CheckReport sums up the check of the repositories of a kind
+type CheckReport struct {
+	Failures     map[string]uint `json:"failures,omitempty"`
+	Repositories uint            `json:"repositories"`
+	NewReleases  uint            `json:"new_releases"`
+	Duration     time.Duration   `json:"duration"`
+}
+
+func (c CheckReport) FailuresCount() uint {
+	var count uint
+	for _, failures := range c.Failures {
+		count += failures
+	}
+
+	return count
+}
+
+// Report sums up a run of the checker or of the notifier
+type Report struct {
+	StartedAt          time.Time              `json:"started_at"`
+	Name               string                 `json:"name"`
+	Checks             map[string]CheckReport `json:"checks,omitempty"`
+	Error              string                 `json:"error,omitempty"`
+	Duration           time.Duration          `json:"duration"`
+	NewReleases        uint                   `json:"new_releases"`
+	YankedReleases     uint                   `json:"yanked_releases"`
+	AutoUpdates        uint                   `json:"auto_updates"`
+	AutoUpdateFailures uint                   `json:"auto_update_failures"`
+	UsersNotified      uint                   `json:"users_notified"`
+	Delivered          uint                   `json:"delivered"`
+	DeliveryFailures   uint                   `json:"delivery_failures"`
+	DryRun             bool                   `json:"dry_run,omitempty"`
+}
+
+func NewReport(name string, startedAt time.Time) Report {
+	return Report{
+		Name:      name,
+		StartedAt: startedAt,
+	}
+}
+
+// AddCheck merges the check of a kind into the report
+func (r *Report) AddCheck(kind RepositoryKind, check CheckReport) {
+	if r.Checks == nil {
+		r.Checks = make(map[string]CheckReport)
+	}
+
+	r.Checks[strings.ToLower(kind.String())] = check
+}
+
+// Summary describes the report in a single line, for the status in the UI
+func (r Report) Summary() string {
+	var parts []string
+
+	if len(r.Checks) != 0 {
+		var repositories, newReleases, failures uint
+		for _, check := range r.Checks {
+			repositories += check.Repositories
+			newReleases += check.NewReleases
+			failures += check.FailuresCount()
+		}
+
+		parts = append(parts, fmt.Sprintf("%d repositories checked, %d new releases, %d failures", repositories, newReleases, failures))
+	}
+
+	if r.Name == NotifierReport {
+		parts = append(parts, fmt.Sprintf("%d releases notified, %d auto-updates, %d users notified, %d notifications sent, %d failed", r.NewReleases, r.AutoUpdates, r.UsersNotified, r.Delivered, r.DeliveryFailures))
+	}
+
+	if len(r.Error) != 0 {
+		parts = append(parts, "error: "+r.Error)
+	}
+
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,48 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSummary(t *testing.T) {
+	t.Parallel()
+
+	startedAt := time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)
+
+	check := NewReport("github", startedAt)
+	check.AddCheck(Github, CheckReport{Repositories: 120, NewReleases: 3, Failures: map[string]uint{"timeout": 2, "not_found": 1}})
+
+	notifier := NewReport(NotifierReport, startedAt)
+	notifier.AddCheck(Github, CheckReport{Repositories: 120, NewReleases: 3})
+	notifier.AddCheck(NPM, CheckReport{Repositories: 30, NewReleases: 1, Failures: map[string]uint{"timeout": 1}})
+	notifier.NewReleases = 4
+	notifier.AutoUpdates = 1
+	notifier.UsersNotified = 2
+	notifier.Delivered = 3
+	notifier.Error = "deliver notifications: timeout"
+
+	cases := map[string]struct {
+		instance Report
+		want     string
+	}{
+		"check": {
+			check,
+			"120 repositories checked, 3 new releases, 3 failures",
+		},
+		"notifier": {
+			notifier,
+			"150 repositories checked, 4 new releases, 1 failures, 4 releases notified, 1 auto-updates, 2 users notified, 3 notifications sent, 0 failed, error: deliver notifications: timeout",
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCase.instance.Summary(); got != testCase.want {
+				t.Errorf("Summary() = `%s`, want `%s`", got, testCase.want)
+			}
+		})
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+
+	"github.com/ViBiOh/ketchup/pkg/semver"
 )
 
 const (
@@ -43,6 +45,11 @@ func ParseRepositoryKind(value string) (RepositoryKind, error) {
 	return Github, ErrUnknownRepositoryKind
 }
 
+// SupportsYank tells if the registry can withdraw a published version
+func (r RepositoryKind) SupportsYank() bool {
+	return r == NPM || r == Pypi
+}
+
 func (r RepositoryKind) MarshalJSON() ([]byte, error) {
 	buffer := bytes.NewBufferString(`"`)
 	buffer.WriteString(r.String())
@@ -117,6 +124,15 @@ func (r Repository) String() string {
 	}
 }
 
+func (r Repository) ParseVersion(version string) (semver.Version, error) {
+	switch r.Kind {
+	case Pypi:
+		return semver.ParsePEP440(version)
+	default:
+		return semver.Parse(version, semver.ExtractName(r.Name))
+	}
+}
+
 func (r Repository) URL(pattern string) string {
 	return r.VersionURL(r.Versions[pattern])
 }
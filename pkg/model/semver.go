@@ -24,7 +24,15 @@ func PreparePatternMatching(patterns []string) (map[string]semver.Version, map[s
 }
 
 func CheckPatternsMatching(versions map[string]semver.Version, patterns map[string]semver.Pattern, version semver.Version) {
+	if version.IsYanked() {
+		return
+	}
+
 	for pattern, patternVersion := range versions {
+		if _, ok := patterns[pattern].DistTag(); ok {
+			continue
+		}
+
 		if patterns[pattern].Check(version) && version.IsGreater(patternVersion) {
 			versions[pattern] = version
 		}
@@ -81,6 +81,34 @@ func TestCheckPatternsMatching(t *testing.T) {
 				"~1.2":   safeParse("1.2.3"),
 			},
 		},
+		"yanked": {
+			args{
+				versions: map[string]semver.Version{
+					"stable": {},
+				},
+				compiledPatterns: map[string]semver.Pattern{
+					"stable": safeParsePattern("stable"),
+				},
+				version: safeParse("1.2.3").Yank(),
+			},
+			map[string]semver.Version{
+				"stable": {},
+			},
+		},
+		"dist-tag": {
+			args{
+				versions: map[string]semver.Version{
+					"dist-tag:next": {},
+				},
+				compiledPatterns: map[string]semver.Pattern{
+					"dist-tag:next": safeParsePattern("dist-tag:next"),
+				},
+				version: safeParse("1.2.3"),
+			},
+			map[string]semver.Version{
+				"dist-tag:next": {},
+			},
+		},
 	}
 
 	for intention, testCase := range cases {
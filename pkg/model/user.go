@@ -3,6 +3,7 @@ package model
 import (
 	"context"
 	"fmt"
+	"time"
 
 	authModel "github.com/ViBiOh/auth/v3/pkg/model"
 )
@@ -13,10 +14,19 @@ const (
 	ctxUserKey key = iota
 )
 
+const (
+	DefaultTimezone  = "Europe/Paris"
+	DefaultHour      = 8
+	DefaultWeeklyDay = time.Monday
+)
+
 type User struct {
-	Email string         `json:"email"`
-	Base  authModel.User `json:"login"`
-	ID    Identifier     `json:"id"`
+	Email     string         `json:"email"`
+	Timezone  string         `json:"timezone"`
+	Base      authModel.User `json:"login"`
+	ID        Identifier     `json:"id"`
+	Hour      uint           `json:"hour"`
+	WeeklyDay time.Weekday   `json:"weekly_day"`
 }
 
 func (u User) String() string {
@@ -41,6 +51,58 @@ func (u User) IsZero() bool {
 	return u.ID.IsZero() && len(u.Base.ID) == 0
 }
 
+// Location gives the timezone of the user, UTC when unset or unknown
+func (u User) Location() *time.Location {
+	if len(u.Timezone) == 0 {
+		return time.UTC
+	}
+
+	location, err := time.LoadLocation(u.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+
+	return location
+}
+
+// NextDelivery gives the start of the first delivery slot of the user not before the hour of now
+func (u User) NextDelivery(now time.Time) time.Time {
+	local := now.In(u.Location())
+
+	slot := time.Date(local.Year(), local.Month(), local.Day(), int(u.Hour), 0, 0, 0, local.Location())
+	if slot.Before(time.Date(local.Year(), local.Month(), local.Day(), local.Hour(), 0, 0, 0, local.Location())) {
+		slot = slot.AddDate(0, 0, 1)
+	}
+
+	return slot
+}
+
+// LastWeeklySlot gives the start of the latest weekly delivery slot of the user not after now
+func (u User) LastWeeklySlot(now time.Time) time.Time {
+	local := now.In(u.Location())
+
+	slot := time.Date(local.Year(), local.Month(), local.Day(), int(u.Hour), 0, 0, 0, local.Location())
+	slot = slot.AddDate(0, 0, -int((local.Weekday()-u.WeeklyDay+7)%7))
+
+	if slot.After(local) {
+		slot = slot.AddDate(0, 0, -7)
+	}
+
+	return slot
+}
+
+// LastMonthlySlot gives the start of the latest monthly delivery slot of the user not after now, on the first day of the month
+func (u User) LastMonthlySlot(now time.Time) time.Time {
+	local := now.In(u.Location())
+
+	slot := time.Date(local.Year(), local.Month(), 1, int(u.Hour), 0, 0, 0, local.Location())
+	if slot.After(local) {
+		slot = slot.AddDate(0, -1, 0)
+	}
+
+	return slot
+}
+
 func NewUser(id Identifier, email string, user authModel.User) User {
 	return User{
 		ID:    id,
@@ -3,6 +3,7 @@ package model
 import (
 	"context"
 	"testing"
+	"time"
 )
 
 func TestReadUser(t *testing.T) {
@@ -46,3 +47,133 @@ func TestReadUser(t *testing.T) {
 		})
 	}
 }
+
+func TestNextDelivery(t *testing.T) {
+	t.Parallel()
+
+	paris, _ := time.LoadLocation("Europe/Paris")
+
+	cases := map[string]struct {
+		user User
+		now  time.Time
+		want time.Time
+	}{
+		"due": {
+			User{Timezone: "Europe/Paris", Hour: 8},
+			time.Date(2026, 10, 19, 6, 5, 0, 0, time.UTC),
+			time.Date(2026, 10, 19, 8, 0, 0, 0, paris),
+		},
+		"later today": {
+			User{Timezone: "Europe/Paris", Hour: 18},
+			time.Date(2026, 10, 19, 6, 5, 0, 0, time.UTC),
+			time.Date(2026, 10, 19, 18, 0, 0, 0, paris),
+		},
+		"tomorrow": {
+			User{Timezone: "Europe/Paris", Hour: 7},
+			time.Date(2026, 10, 19, 6, 5, 0, 0, time.UTC),
+			time.Date(2026, 10, 20, 7, 0, 0, 0, paris),
+		},
+		"unknown timezone": {
+			User{Timezone: "Mars/Olympus", Hour: 7},
+			time.Date(2026, 10, 19, 6, 5, 0, 0, time.UTC),
+			time.Date(2026, 10, 19, 7, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCase.user.NextDelivery(testCase.now); !got.Equal(testCase.want) {
+				t.Errorf("NextDelivery() = %s, want %s", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestLastWeeklySlot(t *testing.T) {
+	t.Parallel()
+
+	newYork, _ := time.LoadLocation("America/New_York")
+
+	cases := map[string]struct {
+		user User
+		now  time.Time
+		want time.Time
+	}{
+		"within slot": {
+			User{Timezone: "America/New_York", Hour: 20, WeeklyDay: time.Sunday},
+			time.Date(2026, 10, 19, 0, 30, 0, 0, time.UTC),
+			time.Date(2026, 10, 18, 20, 0, 0, 0, newYork),
+		},
+		"later hour": {
+			User{Timezone: "America/New_York", Hour: 8, WeeklyDay: time.Sunday},
+			time.Date(2026, 10, 19, 0, 30, 0, 0, time.UTC),
+			time.Date(2026, 10, 18, 8, 0, 0, 0, newYork),
+		},
+		"later day": {
+			User{Timezone: "America/New_York", Hour: 20, WeeklyDay: time.Wednesday},
+			time.Date(2026, 10, 19, 0, 30, 0, 0, time.UTC),
+			time.Date(2026, 10, 14, 20, 0, 0, 0, newYork),
+		},
+		"before hour of the day": {
+			User{Timezone: "America/New_York", Hour: 21, WeeklyDay: time.Sunday},
+			time.Date(2026, 10, 19, 0, 30, 0, 0, time.UTC),
+			time.Date(2026, 10, 11, 21, 0, 0, 0, newYork),
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCase.user.LastWeeklySlot(testCase.now); !got.Equal(testCase.want) {
+				t.Errorf("LastWeeklySlot() = %s, want %s", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestLastMonthlySlot(t *testing.T) {
+	t.Parallel()
+
+	paris, _ := time.LoadLocation("Europe/Paris")
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+
+	cases := map[string]struct {
+		user User
+		now  time.Time
+		want time.Time
+	}{
+		"within slot": {
+			User{Timezone: "Europe/Paris", Hour: 8},
+			time.Date(2026, 10, 1, 6, 0, 0, 0, time.UTC),
+			time.Date(2026, 10, 1, 8, 0, 0, 0, paris),
+		},
+		"before hour": {
+			User{Timezone: "Europe/Paris", Hour: 9},
+			time.Date(2026, 10, 1, 6, 0, 0, 0, time.UTC),
+			time.Date(2026, 9, 1, 9, 0, 0, 0, paris),
+		},
+		"last day in UTC": {
+			User{Timezone: "Asia/Tokyo", Hour: 8},
+			time.Date(2026, 9, 30, 23, 0, 0, 0, time.UTC),
+			time.Date(2026, 10, 1, 8, 0, 0, 0, tokyo),
+		},
+		"later day": {
+			User{Timezone: "Europe/Paris", Hour: 8},
+			time.Date(2026, 10, 19, 6, 0, 0, 0, time.UTC),
+			time.Date(2026, 10, 1, 8, 0, 0, 0, paris),
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCase.user.LastMonthlySlot(testCase.now); !got.Equal(testCase.want) {
+				t.Errorf("LastMonthlySlot() = %s, want %s", got, testCase.want)
+			}
+		})
+	}
+}
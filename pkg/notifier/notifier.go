@@ -1,148 +1,299 @@
 package notifier
 
 import (
+	"bytes"
+	"cmp"
 	"context"
+	"errors"
 	"flag"
 	"fmt"
+	"io"
 	"log/slog"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/ViBiOh/flags"
 	"github.com/ViBiOh/httputils/v4/pkg/breaksync"
+	"github.com/ViBiOh/ketchup/pkg/metric"
 	"github.com/ViBiOh/ketchup/pkg/model"
-	"github.com/ViBiOh/ketchup/pkg/semver"
 	"github.com/ViBiOh/ketchup/pkg/service/user"
-	mailerModel "github.com/ViBiOh/mailer/pkg/model"
 )
 
 type GetNow func() time.Time
 
-var pageSize = uint(20)
+// autoUpdate is a ketchup to move to the version it's notified about
+type autoUpdate struct {
+	ketchup model.Ketchup
+	version string
+}
 
 type Service struct {
-	repository model.RepositoryService
-	ketchup    model.KetchupService
-	user       user.Service
-	mailer     model.Mailer
-	helm       model.HelmProvider
-	clock      GetNow
-	dryRun     bool
+	repository   model.RepositoryService
+	release      model.ReleaseService
+	ketchup      model.KetchupService
+	user         user.Service
+	channel      model.ChannelService
+	notification model.NotificationService
+	notifiers    map[model.ChannelKind]model.Notifier
+	checker      model.Checker
+	report       model.ReportService
+	metric       metric.Service
+	helm         model.HelmProvider
+	clock        GetNow
+	retry        uint
+	output       io.Writer
+	previewDir   string
+	previewUser  string
+	backoff      time.Duration
+	backoffLimit time.Duration
+	dryRun       bool
 }
 
 type Config struct {
-	DryRun bool
+	PreviewDir   string
+	PreviewUser  string
+	Retry        uint
+	Backoff      time.Duration
+	BackoffLimit time.Duration
+	DryRun       bool
 }
 
 func Flags(fs *flag.FlagSet, prefix string) *Config {
 	var config Config
 
 	flags.New("DryRun", "Run in dry-run").Prefix(prefix).DocPrefix("notifier").BoolVar(fs, &config.DryRun, false, nil)
+	flags.New("PreviewDir", "Directory where the notifications are rendered in dry-run, standard error if empty").Prefix(prefix).DocPrefix("notifier").StringVar(fs, &config.PreviewDir, "", nil)
+	flags.New("PreviewUser", "Email of the only user whose notifications are rendered in dry-run").Prefix(prefix).DocPrefix("notifier").StringVar(fs, &config.PreviewUser, "", nil)
+	flags.New("Retry", "Number of retries of failed notifications, once all others are sent").Prefix(prefix).DocPrefix("notifier").UintVar(fs, &config.Retry, 2, nil)
+	flags.New("Backoff", "Delay before first retry of failed notifications, doubled on each attempt").Prefix(prefix).DocPrefix("notifier").DurationVar(fs, &config.Backoff, 30*time.Second, nil)
+	flags.New("BackoffLimit", "Maximum total delay waited for retries while holding the lock, the remaining failures being retried on the next run").Prefix(prefix).DocPrefix("notifier").DurationVar(fs, &config.BackoffLimit, 2*time.Minute, nil)
 
 	return &config
 }
 
-func New(config *Config, repositoryService model.RepositoryService, ketchupService model.KetchupService, userService user.Service, mailerService model.Mailer, helmService model.HelmProvider) Service {
+func New(config *Config, repositoryService model.RepositoryService, releaseService model.ReleaseService, ketchupService model.KetchupService, userService user.Service, channelService model.ChannelService, notificationService model.NotificationService, notifiers map[model.ChannelKind]model.Notifier, checker model.Checker, reportService model.ReportService, metricService metric.Service, helmService model.HelmProvider) Service {
 	return Service{
-		clock:      time.Now,
-		repository: repositoryService,
-		ketchup:    ketchupService,
-		user:       userService,
-		mailer:     mailerService,
-		helm:       helmService,
-		dryRun:     config.DryRun,
+		clock:        time.Now,
+		repository:   repositoryService,
+		release:      releaseService,
+		ketchup:      ketchupService,
+		user:         userService,
+		channel:      channelService,
+		notification: notificationService,
+		notifiers:    notifiers,
+		checker:      checker,
+		report:       reportService,
+		metric:       metricService,
+		helm:         helmService,
+		retry:        config.Retry,
+		backoff:      config.Backoff,
+		backoffLimit: config.BackoffLimit,
+		output:       os.Stderr,
+		previewDir:   config.PreviewDir,
+		previewUser:  config.PreviewUser,
+		dryRun:       config.DryRun,
 	}
 }
 
-func (s Service) Notify(ctx context.Context) error {
+// Notify notifies the releases recorded since the previous run, giving back the report of the run, saved unless in dry-run
+func (s Service) Notify(ctx context.Context) (model.Report, error) {
+	report := model.NewReport(model.NotifierReport, s.clock())
+	report.DryRun = s.dryRun
+
+	err := s.notify(ctx, &report)
+
+	report.Duration = s.clock().Sub(report.StartedAt)
+	if err != nil {
+		report.Error = err.Error()
+	}
+
+	if !s.dryRun {
+		if saveErr := s.report.Save(ctx, report); saveErr != nil {
+			slog.LogAttrs(ctx, slog.LevelError, "save notifier report", slog.Any("error", saveErr))
+		}
+	}
+
+	return report, err
+}
+
+func (s Service) notify(ctx context.Context, report *model.Report) error {
 	if !s.dryRun {
 		if err := s.repository.Clean(ctx); err != nil {
 			return fmt.Errorf("clean repository before starting: %w", err)
 		}
 	}
 
-	newReleases, err := s.getNewReleases(ctx)
+	detectedReleases := s.checkRepositories(ctx, report)
+
+	newReleases, err := s.release.ListPending(ctx)
+	if err != nil {
+		return fmt.Errorf("list pending releases: %w", err)
+	}
+
+	yankedReleases, err := s.release.ListYanked(ctx)
 	if err != nil {
-		return fmt.Errorf("get new releases: %w", err)
+		return fmt.Errorf("list yanked releases: %w", err)
 	}
 
+	newReleases, yankedReleases = mergeDetectedReleases(newReleases, yankedReleases, detectedReleases)
+
+	report.NewReleases = uint(len(newReleases))
+
 	sort.Sort(model.ReleaseByRepositoryIDAndPattern(newReleases))
 
-	if !s.dryRun {
-		if err := s.updateRepositories(ctx, newReleases); err != nil {
-			return fmt.Errorf("update repositories: %w", err)
+	if s.dryRun {
+		ketchupsToNotify, _, err := s.getUsersToNotify(ctx, report, newReleases, yankedReleases)
+		if err != nil {
+			return err
 		}
-	}
 
-	ketchupsToNotify, weeklyUsers, err := s.getKetchupToNotify(ctx, newReleases)
-	if err != nil {
-		return fmt.Errorf("get ketchup to notify: %w", err)
+		report.UsersNotified = uint(len(ketchupsToNotify))
+
+		if err := s.previewNotifications(ctx, ketchupsToNotify); err != nil {
+			return fmt.Errorf("preview notifications: %w", err)
+		}
+
+		return nil
 	}
 
-	if !s.dryRun {
-		if err := s.sendNotification(ctx, "ketchup", ketchupsToNotify, weeklyUsers); err != nil {
-			return fmt.Errorf("send notification: %w", err)
+	var autoUpdates []autoUpdate
+
+	// Releases are marked as notified in the same transaction that queues the notifications, so an interrupted run can't lose a digest
+	err = s.notification.DoAtomic(ctx, func(ctx context.Context) error {
+		ketchupsToNotify, updates, err := s.getUsersToNotify(ctx, report, newReleases, yankedReleases)
+		if err != nil {
+			return err
+		}
+
+		if err := s.queueNotifications(ctx, ketchupsToNotify); err != nil {
+			return fmt.Errorf("queue notifications: %w", err)
+		}
+
+		if err := s.release.MarkNotified(ctx, newReleases); err != nil {
+			return fmt.Errorf("mark releases as notified: %w", err)
 		}
+
+		report.UsersNotified = uint(len(ketchupsToNotify))
+		autoUpdates = updates
+
+		return nil
+	})
+
+	// Auto-updates are applied once the notifications are committed, so a failing one can't abort the delivery of everyone
+	if err == nil {
+		s.applyAutoUpdates(ctx, report, autoUpdates)
 	}
 
-	return nil
+	// Due notifications are delivered even if this run failed to queue new ones, so earlier digests aren't held back
+	if deliverErr := s.deliverDueNotifications(ctx, report); deliverErr != nil {
+		err = errors.Join(err, fmt.Errorf("deliver notifications: %w", deliverErr))
+	}
+
+	return err
 }
 
-func (s Service) updateRepositories(ctx context.Context, releases []model.Release) error {
-	if len(releases) == 0 {
+// checkRepositories detects the releases right before notifying them, without the background checks of the web server. In dry-run, nothing is recorded and the releases found are given back instead.
+func (s Service) checkRepositories(ctx context.Context, report *model.Report) []model.Release {
+	if s.checker.Background() {
 		return nil
 	}
 
-	repo := releases[0].Repository
+	var checks map[model.RepositoryKind]model.CheckReport
+	var detected []model.Release
+	var err error
 
-	for _, release := range releases {
-		if release.Repository.ID != repo.ID {
-			if err := s.repository.Update(ctx, repo); err != nil {
-				return fmt.Errorf("update repository `%s`: %w", repo.Name, err)
-			}
+	if s.dryRun {
+		checks, detected, err = s.checker.Preview(ctx)
+	} else {
+		checks, err = s.checker.CheckAll(ctx)
+	}
 
-			repo = release.Repository
-		}
+	if err != nil {
+		slog.LogAttrs(ctx, slog.LevelError, "check repositories", slog.Any("error", err))
+	}
 
-		repo.Versions[release.Pattern] = release.Version.Name
+	for kind, check := range checks {
+		report.AddCheck(kind, check)
 	}
 
-	if err := s.repository.Update(ctx, repo); err != nil {
-		return fmt.Errorf("update repository `%s`: %w", repo.Name, err)
+	return detected
+}
+
+// mergeDetectedReleases adds the releases detected without being recorded to the recorded ones, superseding the pending release of the same pattern
+func mergeDetectedReleases(newReleases, yankedReleases, detected []model.Release) ([]model.Release, []model.Release) {
+	if len(detected) == 0 {
+		return newReleases, yankedReleases
 	}
 
-	return nil
+	detectedNew, detectedYanked := model.SplitYankedReleases(detected)
+
+	newReleases = slices.DeleteFunc(newReleases, func(pending model.Release) bool {
+		return slices.ContainsFunc(detectedNew, func(release model.Release) bool {
+			return release.Repository.ID == pending.Repository.ID && release.Pattern == pending.Pattern
+		})
+	})
+
+	return append(newReleases, detectedNew...), append(yankedReleases, detectedYanked...)
+}
+
+func (s Service) getUsersToNotify(ctx context.Context, report *model.Report, newReleases, yankedReleases []model.Release) (map[model.User][]model.Release, []autoUpdate, error) {
+	var autoUpdates []autoUpdate
+
+	ketchupsToNotify, err := s.getKetchupToNotify(ctx, report, &autoUpdates, newReleases)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get ketchup to notify: %w", err)
+	}
+
+	if err := s.appendYankedKetchupsToUsers(ctx, report, ketchupsToNotify, yankedReleases); err != nil {
+		return nil, nil, fmt.Errorf("get yanked ketchups: %w", err)
+	}
+
+	return ketchupsToNotify, autoUpdates, nil
 }
 
-func (s Service) getKetchupToNotify(ctx context.Context, releases []model.Release) (map[model.User][]model.Release, map[model.Identifier]uint8, error) {
+func (s Service) getKetchupToNotify(ctx context.Context, report *model.Report, autoUpdates *[]autoUpdate, releases []model.Release) (map[model.User][]model.Release, error) {
 	repositories := make([]model.Repository, len(releases))
 	for index, release := range releases {
 		repositories[index] = release.Repository
 	}
 
-	ketchups, err := s.ketchup.ListForRepositories(ctx, repositories, model.Daily, model.None)
+	ketchups, err := s.ketchup.ListForRepositories(ctx, repositories, model.Daily, model.Immediate, model.None)
 	if err != nil {
-		return nil, nil, fmt.Errorf("get ketchups for repositories: %w", err)
+		return nil, fmt.Errorf("get ketchups for repositories: %w", err)
 	}
 
-	slog.LogAttrs(ctx, slog.LevelInfo, "Daily ketchups updates", slog.Int("count", len(ketchups)))
+	slog.LogAttrs(ctx, slog.LevelInfo, "Daily and immediate ketchups updates", slog.Int("count", len(ketchups)))
 
-	userToNotify, userStatuses := s.syncReleasesByUser(ctx, releases, ketchups)
+	userToNotify := s.syncReleasesByUser(ctx, report, autoUpdates, releases, ketchups)
 
-	if s.clock().Weekday() == time.Monday {
-		weeklyKetchups, err := s.ketchup.ListOutdated(ctx)
-		if err != nil {
-			return nil, nil, fmt.Errorf("get weekly ketchups: %w", err)
-		}
+	if err := s.appendMaturedKetchupsToUsers(ctx, report, autoUpdates, userToNotify); err != nil {
+		return nil, fmt.Errorf("get matured ketchups: %w", err)
+	}
+
+	outdatedKetchups, err := s.ketchup.ListOutdated(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get weekly ketchups: %w", err)
+	}
 
-		slog.LogAttrs(ctx, slog.LevelInfo, "Weekly ketchups updates", slog.Int("count", len(weeklyKetchups)))
+	now := s.clock()
+	weeklyKetchups := slices.DeleteFunc(outdatedKetchups, func(ketchup model.Ketchup) bool {
+		return !isReminderDue(ketchup, now) || ketchup.IsSilenced(ketchup.Repository.Versions[ketchup.Pattern], now)
+	})
 
-		s.appendWeeklyKetchupsToUsers(ctx, userToNotify, userStatuses, weeklyKetchups)
+	slog.LogAttrs(ctx, slog.LevelInfo, "Weekly and monthly ketchups updates", slog.Int("count", len(weeklyKetchups)))
+
+	if err := s.appendWeeklyKetchupsToUsers(ctx, report, autoUpdates, userToNotify, weeklyKetchups, now); err != nil {
+		return nil, fmt.Errorf("remind weekly ketchups: %w", err)
 	}
 
 	slog.LogAttrs(ctx, slog.LevelInfo, "Users to notify", slog.Int("count", len(userToNotify)))
 
-	return userToNotify, userStatuses, nil
+	return userToNotify, nil
 }
 
 func releaseKey(r model.Release) []byte {
@@ -153,9 +304,9 @@ func ketchupKey(k model.Ketchup) []byte {
 	return fmt.Appendf(nil, "%10d|%s", k.Repository.ID, k.Pattern)
 }
 
-func (s Service) syncReleasesByUser(ctx context.Context, releases []model.Release, ketchups []model.Ketchup) (map[model.User][]model.Release, map[model.Identifier]uint8) {
+func (s Service) syncReleasesByUser(ctx context.Context, report *model.Report, autoUpdates *[]autoUpdate, releases []model.Release, ketchups []model.Ketchup) map[model.User][]model.Release {
 	usersToNotify := make(map[model.User][]model.Release)
-	userStatuses := make(map[model.Identifier]uint8)
+	now := s.clock()
 
 	sort.Sort(model.ReleaseByRepositoryIDAndPattern(releases))
 	sort.Sort(model.KetchupByRepositoryIDAndPattern(ketchups))
@@ -173,8 +324,9 @@ func (s Service) syncReleasesByUser(ctx context.Context, releases []model.Releas
 			release := items[0].(model.Release)
 			ketchup := items[1].(model.Ketchup)
 
-			if ketchup.Version != release.Version.Name {
-				s.handleKetchupNotification(ctx, usersToNotify, userStatuses, ketchup, release)
+			// Ketchups with a cooldown are notified once the release is old enough, by appendMaturedKetchupsToUsers
+			if ketchup.Version != release.Version.Name && ketchup.Cooldown == 0 && ketchup.Notifies(release) && !ketchup.IsSilenced(release.Version.Name, now) {
+				s.handleKetchupNotification(report, autoUpdates, usersToNotify, ketchup, release)
 			}
 			return nil
 		})
@@ -182,30 +334,151 @@ func (s Service) syncReleasesByUser(ctx context.Context, releases []model.Releas
 		slog.LogAttrs(ctx, slog.LevelError, "synchronise releases and ketchups", slog.Any("error", err))
 	}
 
-	return usersToNotify, userStatuses
+	return usersToNotify
+}
+
+// appendWeeklyKetchupsToUsers reminds the outdated ketchups, recording it for not reminding them again before their next slot
+func (s Service) appendWeeklyKetchupsToUsers(ctx context.Context, report *model.Report, autoUpdates *[]autoUpdate, usersToNotify map[model.User][]model.Release, ketchups []model.Ketchup, now time.Time) error {
+	for _, ketchup := range ketchups {
+		versionName := ketchup.Repository.Versions[ketchup.Pattern]
+
+		latestVersion, err := ketchup.Repository.ParseVersion(versionName)
+		if err != nil {
+			slog.LogAttrs(ctx, slog.LevelError, "parse latest version of ketchup", slog.String("version", versionName), slog.Any("error", err))
+			continue
+		}
+
+		s.handleKetchupNotification(report, autoUpdates, usersToNotify, ketchup, model.NewRelease(ketchup.Repository, ketchup.Pattern, latestVersion))
+
+		if s.dryRun {
+			continue
+		}
+
+		if err := s.ketchup.UpdateRemindedAt(ctx, ketchup, now); err != nil {
+			return fmt.Errorf("update reminded at of %s: %w", ketchup.Repository, err)
+		}
+	}
+
+	return nil
 }
 
-func (s Service) appendWeeklyKetchupsToUsers(ctx context.Context, usersToNotify map[model.User][]model.Release, userStatuses map[model.Identifier]uint8, ketchups []model.Ketchup) {
+// appendMaturedKetchupsToUsers notifies the ketchups whose latest release has been public for their cooldown, once per version
+func (s Service) appendMaturedKetchupsToUsers(ctx context.Context, report *model.Report, autoUpdates *[]autoUpdate, usersToNotify map[model.User][]model.Release) error {
+	now := s.clock()
+
+	ketchups, err := s.ketchup.ListMatured(ctx, now, model.Daily, model.Immediate, model.None)
+	if err != nil {
+		return err
+	}
+
+	slog.LogAttrs(ctx, slog.LevelInfo, "Matured ketchups updates", slog.Int("count", len(ketchups)))
+
 	for _, ketchup := range ketchups {
-		ketchupVersion, err := semver.Parse(ketchup.Version, semver.ExtractName(ketchup.Repository.Name))
+		versionName := ketchup.Repository.Versions[ketchup.Pattern]
+
+		// Silenced versions are kept unnotified, to be picked up once the snooze ends
+		if ketchup.IsSilenced(versionName, now) {
+			continue
+		}
+
+		version, err := ketchup.Repository.ParseVersion(versionName)
 		if err != nil {
-			slog.LogAttrs(ctx, slog.LevelError, "parse version of ketchup", slog.String("version", ketchup.Version), slog.Any("error", err))
+			slog.LogAttrs(ctx, slog.LevelError, "parse version of matured ketchup", slog.String("version", versionName), slog.Any("error", err))
+			continue
+		}
+
+		if release := model.NewRelease(ketchup.Repository, ketchup.Pattern, version); ketchup.Notifies(release) {
+			s.handleKetchupNotification(report, autoUpdates, usersToNotify, ketchup, release)
+		}
+
+		if s.dryRun {
+			continue
+		}
+
+		if err := s.ketchup.UpdateNotifiedVersion(ctx, ketchup, versionName); err != nil {
+			return fmt.Errorf("update notified version of %s: %w", ketchup.Repository, err)
+		}
+	}
+
+	return nil
+}
+
+func yankedKey(repositoryID model.Identifier, version string) string {
+	return fmt.Sprintf("%d|%s", repositoryID, version)
+}
+
+// appendYankedKetchupsToUsers warns the users still on a yanked version, once per version, counting in the report the yanked releases warned about
+func (s Service) appendYankedKetchupsToUsers(ctx context.Context, report *model.Report, usersToNotify map[model.User][]model.Release, releases []model.Release) error {
+	if len(releases) == 0 {
+		return nil
+	}
+
+	yanked := make(map[string]model.Release, len(releases))
+	repositoriesIDs := make(map[model.Identifier]bool)
+	var repositories []model.Repository
+
+	for _, release := range releases {
+		yanked[yankedKey(release.Repository.ID, release.Version.Name)] = release
+
+		if !repositoriesIDs[release.Repository.ID] {
+			repositoriesIDs[release.Repository.ID] = true
+			repositories = append(repositories, release.Repository)
+		}
+	}
+
+	ketchups, err := s.ketchup.ListForRepositories(ctx, repositories, model.Daily, model.Weekly, model.Monthly, model.Immediate)
+	if err != nil {
+		return fmt.Errorf("get ketchups for yanked repositories: %w", err)
+	}
+
+	warned := make(map[string]bool)
+
+	for _, ketchup := range ketchups {
+		key := yankedKey(ketchup.Repository.ID, ketchup.Version)
+
+		release, ok := yanked[key]
+		if !ok || ketchup.YankNotifiedVersion == ketchup.Version {
 			continue
 		}
 
-		s.handleKetchupNotification(ctx, usersToNotify, userStatuses, ketchup, model.NewRelease(ketchup.Repository, ketchup.Pattern, ketchupVersion))
+		if !warned[key] {
+			warned[key] = true
+			report.YankedReleases++
+		}
+
+		slog.LogAttrs(ctx, slog.LevelInfo, "Yanked version in use", slog.String("repo", ketchup.Repository.String()), slog.String("version", ketchup.Version), slog.Uint64("user", uint64(ketchup.User.ID)))
+
+		usersToNotify[ketchup.User] = append(usersToNotify[ketchup.User], model.NewRelease(ketchup.Repository, ketchup.Pattern, release.Version).SetCurrent(ketchup.Version).SetFrequency(ketchup.Frequency))
+
+		if s.dryRun {
+			continue
+		}
+
+		// The warning is queued in the same transaction, so it's recorded as sent once and for all
+		if err := s.ketchup.UpdateYankNotifiedVersion(ctx, ketchup, ketchup.Version); err != nil {
+			return fmt.Errorf("update yank notified version of %s: %w", ketchup.Repository, err)
+		}
+	}
+
+	return nil
+}
+
+// isReminderDue checks if a slot of the user passed since the outdated ketchup was last reminded: the first day of the month for monthly ones, every week for the others
+func isReminderDue(ketchup model.Ketchup, now time.Time) bool {
+	if ketchup.Frequency == model.Monthly {
+		return ketchup.RemindedAt.Before(ketchup.User.LastMonthlySlot(now))
 	}
+
+	return ketchup.RemindedAt.Before(ketchup.User.LastWeeklySlot(now))
 }
 
-func (s Service) handleKetchupNotification(ctx context.Context, usersToNotify map[model.User][]model.Release, userStatuses map[model.Identifier]uint8, ketchup model.Ketchup, release model.Release) {
-	release = s.handleUpdateWhenNotify(ctx, ketchup, release)
+func (s Service) handleKetchupNotification(report *model.Report, autoUpdates *[]autoUpdate, usersToNotify map[model.User][]model.Release, ketchup model.Ketchup, release model.Release) {
+	release = s.handleUpdateWhenNotify(report, autoUpdates, ketchup, release.SetCurrent(ketchup.Version).SetFrequency(ketchup.Frequency))
 
 	if ketchup.Frequency == model.None {
 		return
 	}
 
-	userStatuses[ketchup.User.ID] |= uint8(ketchup.Frequency)
-
 	if usersToNotify[ketchup.User] != nil {
 		for _, userRelease := range usersToNotify[ketchup.User] {
 			if userRelease.Repository.ID == release.Repository.ID {
@@ -219,66 +492,254 @@ func (s Service) handleKetchupNotification(ctx context.Context, usersToNotify ma
 	}
 }
 
-func (s Service) handleUpdateWhenNotify(ctx context.Context, ketchup model.Ketchup, release model.Release) model.Release {
+// handleUpdateWhenNotify records the auto-update of the ketchup, applied by applyAutoUpdates once the notifications are committed
+func (s Service) handleUpdateWhenNotify(report *model.Report, autoUpdates *[]autoUpdate, ketchup model.Ketchup, release model.Release) model.Release {
 	if !ketchup.UpdateWhenNotify {
 		return release
 	}
 
-	log := slog.With("repository", ketchup.Repository.ID).With("user", ketchup.User.ID).With("pattern", ketchup.Pattern)
+	if s.dryRun {
+		report.AutoUpdates++
+	} else {
+		*autoUpdates = append(*autoUpdates, autoUpdate{ketchup: ketchup, version: release.Version.Name})
+	}
 
-	if !s.dryRun {
-		log.InfoContext(ctx, "Auto-updating ketchup", "version", release.Version.Name)
-		if err := s.ketchup.UpdateVersion(ctx, ketchup.User.ID, ketchup.Repository.ID, ketchup.Pattern, release.Version.Name); err != nil {
+	return release.SetUpdated(2)
+}
+
+// applyAutoUpdates moves the ketchups to their notified version, logging a failure instead of stopping the others
+func (s Service) applyAutoUpdates(ctx context.Context, report *model.Report, autoUpdates []autoUpdate) {
+	for _, update := range autoUpdates {
+		ketchup := update.ketchup
+		log := slog.With("repository", ketchup.Repository.ID).With("user", ketchup.User.ID).With("pattern", ketchup.Pattern)
+
+		log.InfoContext(ctx, "Auto-updating ketchup", "version", update.version)
+		err := s.ketchup.UpdateVersion(ctx, ketchup.User.ID, ketchup.Repository.ID, ketchup.Pattern, update.version)
+		s.metric.AutoUpdate(ctx, err)
+
+		if err != nil {
 			log.LogAttrs(ctx, slog.LevelError, "update ketchup", slog.Any("error", err))
-			return release.SetUpdated(1)
+			report.AutoUpdateFailures++
+
+			continue
 		}
-	}
 
-	return release.SetUpdated(2)
+		report.AutoUpdates++
+	}
 }
 
-func (s Service) sendNotification(ctx context.Context, template string, ketchupToNotify map[model.User][]model.Release, userStatuses map[model.Identifier]uint8) error {
-	if len(ketchupToNotify) == 0 {
-		return nil
+// queueNotifications schedules the releases of each user on their next delivery slot, or right away for the immediate ones
+func (s Service) queueNotifications(ctx context.Context, ketchupToNotify map[model.User][]model.Release) error {
+	now := s.clock()
+
+	for ketchupUser, releases := range ketchupToNotify {
+		sort.Sort(model.ReleaseByKindAndName(releases))
+
+		channels, err := s.channel.ListForUser(ctx, ketchupUser)
+		if err != nil {
+			return fmt.Errorf("list channels of %s: %w", ketchupUser, err)
+		}
+
+		nextDelivery := ketchupUser.NextDelivery(now)
+
+		for _, channel := range channels {
+			if _, ok := s.notifiers[channel.Kind]; !ok {
+				slog.LogAttrs(ctx, slog.LevelWarn, "channel is not configured", slog.String("kind", channel.Kind.String()), slog.String("user", ketchupUser.String()))
+				continue
+			}
+
+			var immediateReleases, digestReleases []model.Release
+
+			for _, release := range channel.Filter(releases) {
+				if release.Frequency == model.Immediate {
+					immediateReleases = append(immediateReleases, release)
+				} else {
+					digestReleases = append(digestReleases, release)
+				}
+			}
+
+			if err := s.queueNotification(ctx, channel, immediateReleases, now); err != nil {
+				return err
+			}
+
+			if err := s.queueNotification(ctx, channel, digestReleases, nextDelivery); err != nil {
+				return err
+			}
+		}
 	}
 
-	if s.mailer == nil || !s.mailer.Enabled() {
-		slog.WarnContext(ctx, "mailer is not configured")
-		return nil
+	return nil
+}
+
+// previewNotifications renders what each channel of the users would receive, for reviewing templates and patterns against real data
+func (s Service) previewNotifications(ctx context.Context, ketchupToNotify map[model.User][]model.Release) error {
+	if len(s.previewDir) != 0 {
+		if err := os.MkdirAll(s.previewDir, 0o700); err != nil {
+			return fmt.Errorf("create preview directory: %w", err)
+		}
 	}
 
-	for ketchupUser, releases := range ketchupToNotify {
-		slog.LogAttrs(ctx, slog.LevelInfo, "Sending email", slog.String("to", ketchupUser.Email), slog.Int("count", len(releases)))
+	users := slices.SortedFunc(maps.Keys(ketchupToNotify), func(a, b model.User) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	for _, ketchupUser := range users {
+		if len(s.previewUser) != 0 && !strings.EqualFold(ketchupUser.Email, s.previewUser) {
+			continue
+		}
 
+		releases := ketchupToNotify[ketchupUser]
 		sort.Sort(model.ReleaseByKindAndName(releases))
 
-		payload := map[string]any{
-			"releases": releases,
+		channels, err := s.channel.ListForUser(ctx, ketchupUser)
+		if err != nil {
+			return fmt.Errorf("list channels of %s: %w", ketchupUser, err)
 		}
 
-		var subject string
+		for _, channel := range channels {
+			notifier, ok := s.notifiers[channel.Kind]
+			if !ok {
+				slog.LogAttrs(ctx, slog.LevelWarn, "channel is not configured", slog.String("kind", channel.Kind.String()), slog.String("user", ketchupUser.String()))
+				continue
+			}
 
-		switch userStatuses[ketchupUser.ID] {
-		case uint8(model.Daily | model.Weekly):
-			subject = "Ketchup - Daily & Weekly notification"
-		case uint8(model.Weekly):
-			subject = "Ketchup - Weekly notification"
-		default:
-			subject = "Ketchup - Daily notification"
-		}
+			channelReleases := channel.Filter(releases)
+			if len(channelReleases) == 0 {
+				continue
+			}
 
-		mr := mailerModel.NewMailRequest().
-			Template(template).
-			From("ketchup@vibioh.fr").
-			As("Ketchup").
-			To(ketchupUser.Email).
-			Data(payload).
-			WithSubject(subject)
+			previews, err := notifier.Preview(channel, channelReleases)
+			if err != nil {
+				return fmt.Errorf("preview %s notification of %s: %w", channel.Kind, ketchupUser, err)
+			}
 
-		if err := s.mailer.Send(ctx, mr); err != nil {
-			return fmt.Errorf("send email to %s: %w", ketchupUser.Email, err)
+			for _, preview := range previews {
+				if err := s.writePreview(channel, preview); err != nil {
+					return fmt.Errorf("write %s preview of %s: %w", channel.Kind, ketchupUser, err)
+				}
+			}
 		}
 	}
 
 	return nil
 }
+
+func (s Service) writePreview(channel model.Channel, preview model.Preview) error {
+	name := fmt.Sprintf("%d-%s-%d.%s", channel.User.ID, strings.ToLower(channel.Kind.String()), channel.ID, preview.Format)
+
+	if len(s.previewDir) != 0 {
+		return os.WriteFile(filepath.Join(s.previewDir, name), preview.Content, 0o600)
+	}
+
+	_, err := fmt.Fprintf(s.output, "==> %s <==\n%s\n\n", name, bytes.TrimSpace(preview.Content))
+
+	return err
+}
+
+func (s Service) queueNotification(ctx context.Context, channel model.Channel, releases []model.Release, scheduledAt time.Time) error {
+	if len(releases) == 0 {
+		return nil
+	}
+
+	if _, err := s.notification.Queue(ctx, model.NewNotification(channel, releases, scheduledAt)); err != nil {
+		return fmt.Errorf("queue %s notification of %s: %w", channel.Kind, channel.User, err)
+	}
+
+	return nil
+}
+
+func (s Service) deliverDueNotifications(ctx context.Context, report *model.Report) error {
+	notifications, err := s.notification.ListDue(ctx, s.clock())
+	if err != nil {
+		return fmt.Errorf("list due notifications: %w", err)
+	}
+
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	return s.deliverNotifications(ctx, report, notifications)
+}
+
+// deliverNotifications sends every notification before retrying the failed ones with a backoff, so one failing channel doesn't delay the others
+func (s Service) deliverNotifications(ctx context.Context, report *model.Report, notifications []model.Notification) error {
+	var errs []error
+	var delivered int
+
+	pending := notifications
+	backoff := s.backoff
+	var waited time.Duration
+
+	for retry := uint(0); len(pending) != 0; retry++ {
+		if retry != 0 {
+			slog.LogAttrs(ctx, slog.LevelWarn, "Retrying failed notifications", slog.Int("count", len(pending)), slog.Uint64("retry", uint64(retry)))
+
+			select {
+			case <-ctx.Done():
+				for _, notification := range pending {
+					errs = append(errs, s.completeNotification(ctx, notification, ctx.Err()))
+				}
+
+				pending = nil
+				continue
+			case <-time.After(backoff):
+				waited += backoff
+				backoff *= 2
+			}
+		}
+
+		// The lock is held while waiting, so failures beyond the limit are left to the next run
+		canRetry := retry < s.retry && waited+backoff <= s.backoffLimit
+
+		var failed []model.Notification
+
+		for _, notification := range pending {
+			notifier, ok := s.notifiers[notification.Channel.Kind]
+			if !ok {
+				errs = append(errs, s.completeNotification(ctx, notification, fmt.Errorf("%s channel is not configured", notification.Channel.Kind)))
+				continue
+			}
+
+			err := notifier.Send(ctx, notification.Channel, notification.Releases)
+			if err != nil && canRetry && !errors.Is(err, model.ErrPermanent) {
+				slog.LogAttrs(ctx, slog.LevelWarn, "send notification", slog.String("kind", notification.Channel.Kind.String()), slog.String("user", notification.Channel.User.String()), slog.Any("error", err))
+				failed = append(failed, notification)
+				continue
+			}
+
+			if err == nil {
+				delivered++
+			}
+
+			errs = append(errs, s.completeNotification(ctx, notification, err))
+		}
+
+		pending = failed
+	}
+
+	err := errors.Join(errs...)
+
+	report.Delivered += uint(delivered)
+	report.DeliveryFailures += uint(len(notifications) - delivered)
+
+	slog.LogAttrs(ctx, slog.LevelInfo, "Notifications sent", slog.Int("delivered", delivered), slog.Int("failed", len(notifications)-delivered))
+
+	return err
+}
+
+// completeNotification records the final result of the notification, giving back the error to report for it
+func (s Service) completeNotification(ctx context.Context, notification model.Notification, err error) error {
+	s.metric.Notification(ctx, notification.Channel.Kind, err)
+
+	if updateErr := s.notification.Update(ctx, notification.SetResult(err)); updateErr != nil {
+		slog.LogAttrs(ctx, slog.LevelError, "update notification", slog.Uint64("id", uint64(notification.ID)), slog.Any("error", updateErr))
+	}
+
+	if err == nil {
+		return nil
+	}
+
+	slog.LogAttrs(ctx, slog.LevelError, "send notification", slog.String("kind", notification.Channel.Kind.String()), slog.String("user", notification.Channel.User.String()), slog.Any("error", err))
+
+	return fmt.Errorf("send %s notification to %s: %w", notification.Channel.Kind, notification.Channel.User, err)
+}
@@ -38,7 +38,7 @@ func TestFlags(t *testing.T) {
 		want string
 	}{
 		"simple": {
-			"Usage of simple:\n  -dryRun\n    \t[notifier] Run in dry-run ${SIMPLE_DRY_RUN}\n",
+			"Usage of simple:\n  -backoff duration\n    \t[notifier] Delay before first retry of failed notifications, doubled on each attempt ${SIMPLE_BACKOFF} (default 30s)\n  -backoffLimit duration\n    \t[notifier] Maximum total delay waited for retries while holding the lock, the remaining failures being retried on the next run ${SIMPLE_BACKOFF_LIMIT} (default 2m0s)\n  -dryRun\n    \t[notifier] Run in dry-run ${SIMPLE_DRY_RUN}\n  -previewDir string\n    \t[notifier] Directory where the notifications are rendered in dry-run, standard error if empty ${SIMPLE_PREVIEW_DIR}\n  -previewUser string\n    \t[notifier] Email of the only user whose notifications are rendered in dry-run ${SIMPLE_PREVIEW_USER}\n  -retry uint\n    \t[notifier] Number of retries of failed notifications, once all others are sent ${SIMPLE_RETRY} (default 2)\n",
 		},
 	}
 
@@ -62,99 +62,13 @@ func TestFlags(t *testing.T) {
 	}
 }
 
-func TestGetNewRepositoryReleases(t *testing.T) {
-	t.Parallel()
-
-	type args struct {
-		repo model.Repository
-	}
-
-	cases := map[string]struct {
-		instance Service
-		args     args
-		want     []model.Release
-	}{
-		"empty": {
-			Service{},
-			args{
-				repo: model.NewGithubRepository(model.Identifier(0), ""),
-			},
-			nil,
-		},
-		"no new": {
-			Service{},
-			args{
-				repo: model.NewGithubRepository(model.Identifier(1), repositoryName).AddVersion(model.DefaultPattern, repositoryVersion),
-			},
-			nil,
-		},
-		"invalid version": {
-			Service{},
-			args{
-				repo: model.NewGithubRepository(model.Identifier(1), repositoryName).AddVersion(model.DefaultPattern, "abcde"),
-			},
-			nil,
-		},
-		"not greater": {
-			Service{},
-			args{
-				repo: model.NewGithubRepository(model.Identifier(1), repositoryName).AddVersion(model.DefaultPattern, "1.1.0"),
-			},
-			nil,
-		},
-		"greater": {
-			Service{},
-			args{
-				repo: model.NewGithubRepository(model.Identifier(1), repositoryName).AddVersion(model.DefaultPattern, repositoryVersion),
-			},
-			[]model.Release{
-				model.NewRelease(model.NewGithubRepository(model.Identifier(1), repositoryName).AddVersion(model.DefaultPattern, repositoryVersion), model.DefaultPattern, safeParse("1.1.0")),
-			},
-		},
-	}
-
-	for intention, testCase := range cases {
-		t.Run(intention, func(t *testing.T) {
-			t.Parallel()
-
-			ctrl := gomock.NewController(t)
-
-			mockRepositoryService := mocks.NewRepositoryService(ctrl)
-
-			testCase.instance.repository = mockRepositoryService
-
-			switch intention {
-			case "empty":
-				mockRepositoryService.EXPECT().LatestVersions(gomock.Any(), gomock.Any()).Return(nil, nil)
-			case "no new":
-				mockRepositoryService.EXPECT().LatestVersions(gomock.Any(), gomock.Any()).Return(map[string]semver.Version{
-					model.DefaultPattern: safeParse(repositoryVersion),
-				}, nil)
-			case "invalid version":
-				mockRepositoryService.EXPECT().LatestVersions(gomock.Any(), gomock.Any()).Return(map[string]semver.Version{
-					model.DefaultPattern: safeParse(repositoryVersion),
-				}, nil)
-			case "not greater":
-				mockRepositoryService.EXPECT().LatestVersions(gomock.Any(), gomock.Any()).Return(map[string]semver.Version{
-					model.DefaultPattern: safeParse(repositoryVersion),
-				}, nil)
-			case "greater":
-				mockRepositoryService.EXPECT().LatestVersions(gomock.Any(), gomock.Any()).Return(map[string]semver.Version{
-					model.DefaultPattern: safeParse("1.1.0"),
-				}, nil)
-			}
-
-			if got := testCase.instance.getNewRepositoryReleases(context.TODO(), testCase.args.repo); !reflect.DeepEqual(got, testCase.want) {
-				t.Errorf("getNewRepositoryReleases() = %+v, want %+v", got, testCase.want)
-			}
-		})
-	}
-}
-
 func TestGetKetchupToNotify(t *testing.T) {
 	t.Parallel()
 
 	loginUser := authModel.NewUser("")
+	maturedRepository := model.NewGithubRepository(model.Identifier(5), "vibioh/matured")
+	maturedRepository.AddVersion(model.DefaultPattern, "1.2.0")
+	weeklyRepository := model.NewGithubRepository(model.Identifier(4), "vibioh/weekly").AddVersion(model.DefaultPattern, "1.3.0")
 
 	type args struct {
 		ctx      context.Context
@@ -162,17 +76,15 @@ func TestGetKetchupToNotify(t *testing.T) {
 	}
 
 	cases := map[string]struct {
-		args     args
-		want     map[model.User][]model.Release
-		wantUser map[model.Identifier]uint8
-		wantErr  error
+		args    args
+		want    map[model.User][]model.Release
+		wantErr error
 	}{
 		"list error": {
 			args{
 				ctx: context.TODO(),
 			},
 			nil,
-			nil,
 			errors.New("failed"),
 		},
 		"empty": {
@@ -180,7 +92,6 @@ func TestGetKetchupToNotify(t *testing.T) {
 				ctx: context.TODO(),
 			},
 			make(map[model.User][]model.Release),
-			make(map[model.Identifier]uint8),
 			nil,
 		},
 		"one release, n ketchups": {
@@ -213,35 +124,40 @@ func TestGetKetchupToNotify(t *testing.T) {
 			map[model.User][]model.Release{
 				{ID: 2, Email: "guest@nowhere", Base: loginUser}: {{
 					Pattern: model.DefaultPattern,
+					Current: repositoryVersion,
 					Version: semver.Version{
 						Name: "1.1.0",
 					},
 					Repository: model.NewGithubRepository(model.Identifier(1), repositoryName),
-				}},
+					Frequency:  model.Daily,
+				}, model.NewRelease(maturedRepository, model.DefaultPattern, safeParse("1.2.0")).SetCurrent(repositoryVersion).SetFrequency(model.Daily),
+					model.NewRelease(weeklyRepository, model.DefaultPattern, safeParse("1.3.0")).SetCurrent(repositoryVersion).SetFrequency(model.Weekly)},
 				{ID: 1, Email: testEmail, Base: loginUser}: {{
 					Pattern: model.DefaultPattern,
+					Current: repositoryVersion,
 					Version: semver.Version{
 						Name: "1.1.0",
 					},
 					Repository: model.NewGithubRepository(model.Identifier(1), repositoryName),
+					Frequency:  model.Daily,
 				}, {
 					Pattern: model.DefaultPattern,
+					Current: repositoryVersion,
 					Version: semver.Version{
 						Name: "1.1.0",
 					},
 					Repository: model.NewGithubRepository(model.Identifier(2), "vibioh/dotfiles"),
+					Frequency:  model.Daily,
 				}, {
 					Pattern: model.DefaultPattern,
+					Current: repositoryVersion,
 					Version: semver.Version{
 						Name: "1.1.0",
 					},
 					Repository: model.NewGithubRepository(model.Identifier(3), "vibioh/zzz"),
+					Frequency:  model.Weekly,
 				}},
 			},
-			map[model.Identifier]uint8{
-				1: 3,
-				2: 1,
-			},
 			nil,
 		},
 	}
@@ -265,8 +181,48 @@ func TestGetKetchupToNotify(t *testing.T) {
 
 			case "empty":
 				mockKetchupService.EXPECT().ListForRepositories(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				mockKetchupService.EXPECT().ListMatured(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				mockKetchupService.EXPECT().ListOutdated(gomock.Any()).Return(nil, nil)
 
 			case "one release, n ketchups":
+				maturedKetchup := model.NewKetchup(model.DefaultPattern, repositoryVersion, model.Daily, false, model.NewGithubRepository(model.Identifier(5), "vibioh/matured")).SetCooldown(3)
+				maturedKetchup.User = model.NewUser(2, "guest@nowhere", loginUser)
+				maturedKetchup.Repository.AddVersion(model.DefaultPattern, "1.2.0")
+
+				snoozedKetchup := maturedKetchup
+				snoozedKetchup.User = model.NewUser(5, "snoozed@nowhere", loginUser)
+				snoozedKetchup.SnoozedUntil = time.Unix(1609459200, 0).AddDate(0, 0, 7)
+
+				mockKetchupService.EXPECT().ListMatured(gomock.Any(), gomock.Any(), gomock.Any()).Return([]model.Ketchup{maturedKetchup, snoozedKetchup}, nil)
+				mockKetchupService.EXPECT().UpdateNotifiedVersion(gomock.Any(), maturedKetchup, "1.2.0").Return(nil)
+				weeklyKetchup := model.Ketchup{
+					Pattern:    model.DefaultPattern,
+					Repository: weeklyRepository,
+					User:       model.NewUser(2, "guest@nowhere", loginUser),
+					Version:    repositoryVersion,
+					Frequency:  model.Weekly,
+				}
+
+				mockKetchupService.EXPECT().ListOutdated(gomock.Any()).Return([]model.Ketchup{
+					weeklyKetchup,
+					{
+						Pattern:        model.DefaultPattern,
+						Repository:     weeklyRepository,
+						User:           model.NewUser(4, "skipped@nowhere", loginUser),
+						Version:        repositoryVersion,
+						SkippedVersion: "1.3.0",
+						Frequency:      model.Weekly,
+					},
+					{
+						Pattern:    model.DefaultPattern,
+						Repository: weeklyRepository,
+						User:       model.NewUser(6, "reminded@nowhere", loginUser),
+						Version:    repositoryVersion,
+						RemindedAt: time.Unix(1609459200, 0).AddDate(0, 0, -1),
+						Frequency:  model.Weekly,
+					},
+				}, nil)
+				mockKetchupService.EXPECT().UpdateRemindedAt(gomock.Any(), weeklyKetchup, time.Unix(1609459200, 0)).Return(nil)
 				mockKetchupService.EXPECT().ListForRepositories(gomock.Any(), gomock.Any(), gomock.Any()).Return([]model.Ketchup{
 					{
 						Pattern:    model.DefaultPattern,
@@ -310,10 +266,34 @@ func TestGetKetchupToNotify(t *testing.T) {
 						Version:    "1.1.0",
 						Frequency:  model.Daily,
 					},
+					{
+						Pattern:    model.DefaultPattern,
+						Repository: model.NewGithubRepository(model.Identifier(3), "vibioh/zzz"),
+						User:       model.NewUser(3, "cooldown@nowhere", loginUser),
+						Version:    repositoryVersion,
+						Frequency:  model.Daily,
+						Cooldown:   3,
+					},
+					{
+						Pattern:        model.DefaultPattern,
+						Repository:     model.NewGithubRepository(model.Identifier(1), repositoryName),
+						User:           model.NewUser(4, "skipped@nowhere", loginUser),
+						Version:        repositoryVersion,
+						SkippedVersion: "1.1.0",
+						Frequency:      model.Daily,
+					},
+					{
+						Pattern:      model.DefaultPattern,
+						Repository:   model.NewGithubRepository(model.Identifier(2), "vibioh/dotfiles"),
+						User:         model.NewUser(5, "snoozed@nowhere", loginUser),
+						Version:      repositoryVersion,
+						SnoozedUntil: time.Unix(1609459200, 0).AddDate(0, 0, 7),
+						Frequency:    model.Daily,
+					},
 				}, nil)
 			}
 
-			got, gotUser, gotErr := instance.getKetchupToNotify(testCase.args.ctx, testCase.args.releases)
+			got, gotErr := instance.getKetchupToNotify(testCase.args.ctx, &model.Report{}, new([]autoUpdate), testCase.args.releases)
 
 			failed := false
 
@@ -325,127 +305,644 @@ func TestGetKetchupToNotify(t *testing.T) {
 				failed = true
 			} else if !reflect.DeepEqual(got, testCase.want) {
 				failed = true
-			} else if !reflect.DeepEqual(gotUser, testCase.wantUser) {
-				failed = true
 			}
 
 			if failed {
-				t.Errorf("getKetchupToNotify() = (%+v, %+v, `%s`), want (%+v, %+v, `%s`)", got, gotUser, gotErr, testCase.want, testCase.wantUser, testCase.wantErr)
+				t.Errorf("getKetchupToNotify() = (%+v, `%s`), want (%+v, `%s`)", got, gotErr, testCase.want, testCase.wantErr)
 			}
 		})
 	}
 }
 
-func TestSendNotification(t *testing.T) {
+func TestMergeDetectedReleases(t *testing.T) {
 	t.Parallel()
 
+	repository := model.NewGithubRepository(model.Identifier(1), repositoryName)
+	otherRepository := model.NewGithubRepository(model.Identifier(2), "vibioh/dotfiles")
+
+	pending := model.NewRelease(repository, model.DefaultPattern, safeParse("1.1.0"))
+	otherPending := model.NewRelease(otherRepository, model.DefaultPattern, safeParse("2.0.0"))
+	detected := model.NewRelease(repository, model.DefaultPattern, safeParse("1.2.0"))
+	yanked := model.NewRelease(otherRepository, "", safeParse("1.9.0").Yank())
+
 	type args struct {
-		ctx             context.Context
-		ketchupToNotify map[model.User][]model.Release
-		userStatuses    map[model.Identifier]uint8
+		newReleases    []model.Release
+		yankedReleases []model.Release
+		detected       []model.Release
 	}
 
 	cases := map[string]struct {
-		instance Service
-		args     args
-		wantErr  error
+		args       args
+		wantNew    []model.Release
+		wantYanked []model.Release
+	}{
+		"nothing detected": {
+			args{
+				newReleases: []model.Release{pending},
+			},
+			[]model.Release{pending},
+			nil,
+		},
+		"superseded": {
+			args{
+				newReleases: []model.Release{pending, otherPending},
+				detected:    []model.Release{detected, yanked},
+			},
+			[]model.Release{otherPending, detected},
+			[]model.Release{yanked},
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			gotNew, gotYanked := mergeDetectedReleases(testCase.args.newReleases, testCase.args.yankedReleases, testCase.args.detected)
+
+			if !reflect.DeepEqual(gotNew, testCase.wantNew) || !reflect.DeepEqual(gotYanked, testCase.wantYanked) {
+				t.Errorf("mergeDetectedReleases() = (%+v, %+v), want (%+v, %+v)", gotNew, gotYanked, testCase.wantNew, testCase.wantYanked)
+			}
+		})
+	}
+}
+
+func TestAppendYankedKetchupsToUsers(t *testing.T) {
+	t.Parallel()
+
+	loginUser := authModel.NewUser("")
+	repository := model.NewRepository(model.Identifier(1), model.NPM, "left-pad", "")
+
+	type args struct {
+		releases []model.Release
+	}
+
+	cases := map[string]struct {
+		args       args
+		want       map[model.User][]model.Release
+		wantYanked uint
+		wantErr    error
 	}{
 		"empty": {
-			Service{},
+			args{},
+			make(map[model.User][]model.Release),
+			0,
+			nil,
+		},
+		"list error": {
 			args{
-				ctx:             context.TODO(),
-				ketchupToNotify: nil,
+				releases: []model.Release{model.NewRelease(repository, "", safeParse("1.0.0").Yank())},
+			},
+			make(map[model.User][]model.Release),
+			0,
+			errors.New("failed"),
+		},
+		"already warned": {
+			args{
+				releases: []model.Release{model.NewRelease(repository, "", safeParse("1.0.0").Yank())},
 			},
+			make(map[model.User][]model.Release),
+			0,
 			nil,
 		},
-		"no mailer": {
-			Service{},
+		"version in use": {
 			args{
-				ctx: context.TODO(),
-				ketchupToNotify: map[model.User][]model.Release{
-					{
-						ID:    1,
-						Email: testEmail,
-					}: {
-						{
-							Repository: model.NewGithubRepository(model.Identifier(1), repositoryName),
-							Version: semver.Version{
-								Name: repositoryVersion,
-							},
-						},
-					},
+				releases: []model.Release{
+					model.NewRelease(repository, "", safeParse("1.0.0").Yank()),
+					model.NewRelease(repository, "", safeParse("1.0.1").Yank()),
 				},
 			},
+			map[model.User][]model.Release{
+				{ID: 1, Email: testEmail, Base: loginUser}: {
+					model.NewRelease(repository, model.DefaultPattern, safeParse("1.0.0").Yank()).SetCurrent("1.0.0").SetFrequency(model.Daily),
+				},
+				{ID: 3, Email: "weekly@nowhere", Base: loginUser}: {
+					model.NewRelease(repository, model.DefaultPattern, safeParse("1.0.1").Yank()).SetCurrent("1.0.1").SetFrequency(model.Weekly),
+				},
+			},
+			2,
 			nil,
 		},
-		"mailer disabled": {
-			Service{},
-			args{
-				ctx: context.TODO(),
-				ketchupToNotify: map[model.User][]model.Release{
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			mockKetchupService := mocks.NewKetchupService(ctrl)
+
+			instance := Service{
+				ketchup: mockKetchupService,
+				clock:   func() time.Time { return time.Unix(1609459200, 0) },
+			}
+
+			switch intention {
+			case "list error":
+				mockKetchupService.EXPECT().ListForRepositories(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("failed"))
+			case "already warned":
+				mockKetchupService.EXPECT().ListForRepositories(gomock.Any(), gomock.Any(), gomock.Any()).Return([]model.Ketchup{
 					{
-						ID:    1,
-						Email: testEmail,
-					}: {
-						{
-							Repository: model.NewGithubRepository(model.Identifier(1), repositoryName),
-							Version: semver.Version{
-								Name: repositoryVersion,
-							},
-						},
+						Pattern:             model.DefaultPattern,
+						Repository:          repository,
+						User:                model.NewUser(1, testEmail, loginUser),
+						Version:             "1.0.0",
+						YankNotifiedVersion: "1.0.0",
+						Frequency:           model.Daily,
+					},
+				}, nil)
+			case "version in use":
+				mockKetchupService.EXPECT().ListForRepositories(gomock.Any(), gomock.Any(), gomock.Any()).Return([]model.Ketchup{
+					{
+						Pattern:    model.DefaultPattern,
+						Repository: repository,
+						User:       model.NewUser(1, testEmail, loginUser),
+						Version:    "1.0.0",
+						Frequency:  model.Daily,
+					},
+					{
+						Pattern:    model.DefaultPattern,
+						Repository: repository,
+						User:       model.NewUser(2, "guest@nowhere", loginUser),
+						Version:    "1.1.0",
+						Frequency:  model.Daily,
 					},
+					{
+						Pattern:    model.DefaultPattern,
+						Repository: repository,
+						User:       model.NewUser(3, "weekly@nowhere", loginUser),
+						Version:    "1.0.1",
+						Frequency:  model.Weekly,
+					},
+				}, nil)
+				mockKetchupService.EXPECT().UpdateYankNotifiedVersion(gomock.Any(), gomock.Any(), "1.0.0").Return(nil)
+				mockKetchupService.EXPECT().UpdateYankNotifiedVersion(gomock.Any(), gomock.Any(), "1.0.1").Return(nil)
+			}
+
+			got := make(map[model.User][]model.Release)
+			var report model.Report
+
+			gotErr := instance.appendYankedKetchupsToUsers(context.TODO(), &report, got, testCase.args.releases)
+
+			failed := false
+
+			if testCase.wantErr == nil && gotErr != nil {
+				failed = true
+			} else if testCase.wantErr != nil && gotErr == nil {
+				failed = true
+			} else if testCase.wantErr != nil && !strings.Contains(gotErr.Error(), testCase.wantErr.Error()) {
+				failed = true
+			} else if !reflect.DeepEqual(got, testCase.want) {
+				failed = true
+			} else if report.YankedReleases != testCase.wantYanked {
+				failed = true
+			}
+
+			if failed {
+				t.Errorf("appendYankedKetchupsToUsers() = (%+v, %d, `%s`), want (%+v, %d, `%s`)", got, report.YankedReleases, gotErr, testCase.want, testCase.wantYanked, testCase.wantErr)
+			}
+		})
+	}
+}
+
+func TestAppendWeeklyKetchupsToUsers(t *testing.T) {
+	t.Parallel()
+
+	loginUser := authModel.NewUser("")
+	repository := model.NewGithubRepository(model.Identifier(4), "vibioh/weekly").AddVersion(model.DefaultPattern, "1.2.0")
+	now := time.Unix(1609459200, 0)
+
+	weeklyKetchup := model.Ketchup{
+		Pattern:    model.DefaultPattern,
+		Repository: repository,
+		User:       model.NewUser(1, testEmail, loginUser),
+		Version:    repositoryVersion,
+		Frequency:  model.Weekly,
+	}
+
+	cases := map[string]struct {
+		ketchups []model.Ketchup
+		want     map[model.User][]model.Release
+		wantErr  error
+	}{
+		"latest version": {
+			[]model.Ketchup{weeklyKetchup},
+			map[model.User][]model.Release{
+				{ID: 1, Email: testEmail, Base: loginUser}: {
+					model.NewRelease(repository, model.DefaultPattern, safeParse("1.2.0")).SetCurrent(repositoryVersion).SetFrequency(model.Weekly),
 				},
 			},
 			nil,
 		},
-		"mailer error": {
-			Service{},
-			args{
-				ctx: context.TODO(),
-				ketchupToNotify: map[model.User][]model.Release{
-					{
-						ID:    1,
-						Email: testEmail,
-					}: {
-						{
-							Repository: model.NewGithubRepository(model.Identifier(1), repositoryName),
-							Version: semver.Version{
-								Name: repositoryVersion,
-							},
-						},
-					},
+		"invalid version": {
+			[]model.Ketchup{
+				{
+					Pattern:    model.DefaultPattern,
+					Repository: model.NewGithubRepository(model.Identifier(5), "vibioh/invalid").AddVersion(model.DefaultPattern, "not a version"),
+					User:       model.NewUser(1, testEmail, loginUser),
+					Version:    repositoryVersion,
+					Frequency:  model.Weekly,
 				},
 			},
-			errors.New("send email to nobody@localhost: invalid context"),
+			make(map[model.User][]model.Release),
+			nil,
 		},
-		"multiple releases": {
-			Service{},
+		"update error": {
+			[]model.Ketchup{weeklyKetchup},
+			map[model.User][]model.Release{
+				{ID: 1, Email: testEmail, Base: loginUser}: {
+					model.NewRelease(repository, model.DefaultPattern, safeParse("1.2.0")).SetCurrent(repositoryVersion).SetFrequency(model.Weekly),
+				},
+			},
+			errors.New("update reminded at"),
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			mockKetchupService := mocks.NewKetchupService(ctrl)
+
+			instance := Service{
+				ketchup: mockKetchupService,
+			}
+
+			switch intention {
+			case "latest version":
+				mockKetchupService.EXPECT().UpdateRemindedAt(gomock.Any(), weeklyKetchup, now).Return(nil)
+			case "update error":
+				mockKetchupService.EXPECT().UpdateRemindedAt(gomock.Any(), weeklyKetchup, now).Return(errors.New("failed"))
+			}
+
+			got := make(map[model.User][]model.Release)
+
+			gotErr := instance.appendWeeklyKetchupsToUsers(context.TODO(), &model.Report{}, new([]autoUpdate), got, testCase.ketchups, now)
+
+			failed := false
+
+			if testCase.wantErr == nil && gotErr != nil {
+				failed = true
+			} else if testCase.wantErr != nil && gotErr == nil {
+				failed = true
+			} else if testCase.wantErr != nil && !strings.Contains(gotErr.Error(), testCase.wantErr.Error()) {
+				failed = true
+			} else if !reflect.DeepEqual(got, testCase.want) {
+				failed = true
+			}
+
+			if failed {
+				t.Errorf("appendWeeklyKetchupsToUsers() = (%+v, `%s`), want (%+v, `%s`)", got, gotErr, testCase.want, testCase.wantErr)
+			}
+		})
+	}
+}
+
+func TestApplyAutoUpdates(t *testing.T) {
+	t.Parallel()
+
+	loginUser := authModel.NewUser("")
+	repository := model.NewGithubRepository(model.Identifier(4), "vibioh/auto")
+
+	firstKetchup := model.Ketchup{Pattern: model.DefaultPattern, Repository: repository, User: model.NewUser(1, testEmail, loginUser), Version: repositoryVersion, UpdateWhenNotify: true}
+	otherKetchup := model.Ketchup{Pattern: model.DefaultPattern, Repository: repository, User: model.NewUser(2, "other@localhost", loginUser), Version: repositoryVersion, UpdateWhenNotify: true}
+
+	autoUpdates := []autoUpdate{{ketchup: firstKetchup, version: "1.2.0"}, {ketchup: otherKetchup, version: "1.2.0"}}
+
+	cases := map[string]struct {
+		want model.Report
+	}{
+		"updated": {
+			model.Report{AutoUpdates: 2},
+		},
+		"update error": {
+			model.Report{AutoUpdates: 1, AutoUpdateFailures: 1},
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			mockKetchupService := mocks.NewKetchupService(ctrl)
+
+			instance := Service{
+				ketchup: mockKetchupService,
+			}
+
+			switch intention {
+			case "updated":
+				mockKetchupService.EXPECT().UpdateVersion(gomock.Any(), firstKetchup.User.ID, repository.ID, model.DefaultPattern, "1.2.0").Return(nil)
+				mockKetchupService.EXPECT().UpdateVersion(gomock.Any(), otherKetchup.User.ID, repository.ID, model.DefaultPattern, "1.2.0").Return(nil)
+			case "update error":
+				mockKetchupService.EXPECT().UpdateVersion(gomock.Any(), firstKetchup.User.ID, repository.ID, model.DefaultPattern, "1.2.0").Return(errors.New("failed"))
+				mockKetchupService.EXPECT().UpdateVersion(gomock.Any(), otherKetchup.User.ID, repository.ID, model.DefaultPattern, "1.2.0").Return(nil)
+			}
+
+			var got model.Report
+
+			instance.applyAutoUpdates(context.TODO(), &got, autoUpdates)
+
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("applyAutoUpdates() = %+v, want %+v", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestQueueNotifications(t *testing.T) {
+	t.Parallel()
+
+	user := model.User{ID: 1, Email: testEmail}
+	releases := []model.Release{
+		{
+			Repository: model.NewGithubRepository(model.Identifier(1), repositoryName),
+			Version: semver.Version{
+				Name: repositoryVersion,
+			},
+			Frequency: model.Daily,
+		},
+		{
+			Repository: model.NewGithubRepository(model.Identifier(2), "vibioh/viws"),
+			Version: semver.Version{
+				Name: repositoryVersion,
+			},
+			Frequency: model.Weekly,
+		},
+	}
+
+	now := time.Date(2021, 1, 1, 0, 30, 0, 0, time.UTC)
+	scheduledAt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	immediateRelease := model.Release{
+		Repository: model.NewGithubRepository(model.Identifier(3), "vibioh/ketchup"),
+		Version: semver.Version{
+			Name: repositoryVersion,
+		},
+		Frequency: model.Immediate,
+	}
+
+	slackChannel := model.Channel{Kind: model.Slack, URL: "https://hooks.slack.com/services/T0/B0/X", User: user, Frequencies: []model.KetchupFrequency{model.Weekly}}
+
+	type args struct {
+		ketchupToNotify map[model.User][]model.Release
+	}
+
+	cases := map[string]struct {
+		args    args
+		wantErr error
+	}{
+		"empty": {
 			args{
-				ctx: context.TODO(),
-				ketchupToNotify: map[model.User][]model.Release{
-					{
-						ID:    1,
-						Email: testEmail,
-					}: {
-						{
-							Repository: model.NewGithubRepository(model.Identifier(1), repositoryName),
-							Version: semver.Version{
-								Name: repositoryVersion,
-							},
-						},
-						{
-							Repository: model.NewGithubRepository(model.Identifier(2), "vibioh/viws"),
-							Version: semver.Version{
-								Name: repositoryVersion,
-							},
-						},
-					},
+				ketchupToNotify: nil,
+			},
+			nil,
+		},
+		"channels error": {
+			args{
+				ketchupToNotify: map[model.User][]model.Release{user: releases},
+			},
+			errors.New("list channels of id=1,email=`nobody@localhost`: failed"),
+		},
+		"not configured": {
+			args{
+				ketchupToNotify: map[model.User][]model.Release{user: releases},
+			},
+			nil,
+		},
+		"queue error": {
+			args{
+				ketchupToNotify: map[model.User][]model.Release{user: releases},
+			},
+			errors.New("queue Email notification of id=1,email=`nobody@localhost`: failed"),
+		},
+		"routed by frequency": {
+			args{
+				ketchupToNotify: map[model.User][]model.Release{user: releases},
+			},
+			nil,
+		},
+		"no release for channel": {
+			args{
+				ketchupToNotify: map[model.User][]model.Release{user: releases[:1]},
+			},
+			nil,
+		},
+		"immediate": {
+			args{
+				ketchupToNotify: map[model.User][]model.Release{user: append([]model.Release{immediateRelease}, releases[:1]...)},
+			},
+			nil,
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			mockChannelService := mocks.NewChannelService(ctrl)
+			mockNotificationService := mocks.NewNotificationService(ctrl)
+
+			instance := Service{
+				channel:      mockChannelService,
+				notification: mockNotificationService,
+				clock:        func() time.Time { return now },
+				notifiers: map[model.ChannelKind]model.Notifier{
+					model.Email: mocks.NewNotifier(ctrl),
+					model.Slack: mocks.NewNotifier(ctrl),
 				},
-				userStatuses: map[model.Identifier]uint8{
-					1: 1,
-					2: 3,
+			}
+
+			switch intention {
+			case "channels error":
+				mockChannelService.EXPECT().ListForUser(gomock.Any(), user).Return(nil, errors.New("failed"))
+			case "not configured":
+				mockChannelService.EXPECT().ListForUser(gomock.Any(), user).Return([]model.Channel{{Kind: model.Discord, User: user, Frequencies: []model.KetchupFrequency{model.Daily}}}, nil)
+			case "queue error":
+				mockChannelService.EXPECT().ListForUser(gomock.Any(), user).Return([]model.Channel{model.NewEmailChannel(user)}, nil)
+				mockNotificationService.EXPECT().Queue(gomock.Any(), gomock.Any()).Return(model.Notification{}, errors.New("failed"))
+			case "routed by frequency":
+				mockChannelService.EXPECT().ListForUser(gomock.Any(), user).Return([]model.Channel{model.NewEmailChannel(user), slackChannel}, nil)
+				mockNotificationService.EXPECT().Queue(gomock.Any(), model.NewNotification(model.NewEmailChannel(user), releases, scheduledAt)).Return(model.Notification{ID: 1}, nil)
+				mockNotificationService.EXPECT().Queue(gomock.Any(), model.NewNotification(slackChannel, releases[1:], scheduledAt)).Return(model.Notification{ID: 2}, nil)
+			case "no release for channel":
+				mockChannelService.EXPECT().ListForUser(gomock.Any(), user).Return([]model.Channel{slackChannel}, nil)
+			case "immediate":
+				mockChannelService.EXPECT().ListForUser(gomock.Any(), user).Return([]model.Channel{model.NewEmailChannel(user)}, nil)
+				mockNotificationService.EXPECT().Queue(gomock.Any(), model.NewNotification(model.NewEmailChannel(user), []model.Release{immediateRelease}, now)).Return(model.Notification{ID: 1}, nil)
+				mockNotificationService.EXPECT().Queue(gomock.Any(), model.NewNotification(model.NewEmailChannel(user), releases[:1], scheduledAt)).Return(model.Notification{ID: 2}, nil)
+			}
+
+			gotErr := instance.queueNotifications(context.TODO(), testCase.args.ketchupToNotify)
+
+			failed := false
+
+			if testCase.wantErr == nil && gotErr != nil {
+				failed = true
+			} else if testCase.wantErr != nil && gotErr == nil {
+				failed = true
+			} else if testCase.wantErr != nil && !strings.Contains(gotErr.Error(), testCase.wantErr.Error()) {
+				failed = true
+			}
+
+			if failed {
+				t.Errorf("queueNotifications() = `%s`, want `%s`", gotErr, testCase.wantErr)
+			}
+		})
+	}
+}
+
+func TestPreviewNotifications(t *testing.T) {
+	t.Parallel()
+
+	user := model.User{ID: 1, Email: testEmail}
+	otherUser := model.User{ID: 2, Email: "someone@localhost"}
+	releases := []model.Release{
+		{
+			Repository: model.NewGithubRepository(model.Identifier(1), repositoryName),
+			Version: semver.Version{
+				Name: repositoryVersion,
+			},
+			Frequency: model.Daily,
+		},
+	}
+
+	cases := map[string]struct {
+		previewUser string
+		want        string
+		wantErr     error
+	}{
+		"all users": {
+			"",
+			"==> 1-email-0.txt <==\nnobody@localhost\n\n==> 2-email-0.txt <==\nsomeone@localhost\n\n",
+			nil,
+		},
+		"single user": {
+			"Someone@localhost",
+			"==> 2-email-0.txt <==\nsomeone@localhost\n\n",
+			nil,
+		},
+		"preview error": {
+			testEmail,
+			"",
+			errors.New("preview Email notification of id=1,email=`nobody@localhost`: failed"),
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			mockChannelService := mocks.NewChannelService(ctrl)
+			mockNotifier := mocks.NewNotifier(ctrl)
+
+			var output strings.Builder
+
+			instance := Service{
+				channel:     mockChannelService,
+				output:      &output,
+				previewUser: testCase.previewUser,
+				notifiers: map[model.ChannelKind]model.Notifier{
+					model.Email: mockNotifier,
 				},
+			}
+
+			mockChannelService.EXPECT().ListForUser(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, user model.User) ([]model.Channel, error) {
+				return []model.Channel{model.NewEmailChannel(user)}, nil
+			}).AnyTimes()
+
+			switch intention {
+			case "preview error":
+				mockNotifier.EXPECT().Preview(model.NewEmailChannel(user), releases).Return(nil, errors.New("failed"))
+			default:
+				mockNotifier.EXPECT().Preview(gomock.Any(), releases).DoAndReturn(func(channel model.Channel, _ []model.Release) ([]model.Preview, error) {
+					return []model.Preview{{Format: "txt", Content: []byte(channel.User.Email)}}, nil
+				}).AnyTimes()
+			}
+
+			gotErr := instance.previewNotifications(context.TODO(), map[model.User][]model.Release{user: releases, otherUser: releases})
+
+			failed := false
+
+			if testCase.wantErr == nil && gotErr != nil {
+				failed = true
+			} else if testCase.wantErr != nil && (gotErr == nil || !strings.Contains(gotErr.Error(), testCase.wantErr.Error())) {
+				failed = true
+			} else if testCase.wantErr == nil && output.String() != testCase.want {
+				failed = true
+			}
+
+			if failed {
+				t.Errorf("previewNotifications() = (`%s`, `%s`), want (`%s`, `%s`)", output.String(), gotErr, testCase.want, testCase.wantErr)
+			}
+		})
+	}
+}
+
+func TestDeliverNotifications(t *testing.T) {
+	t.Parallel()
+
+	user := model.User{ID: 1, Email: testEmail}
+	releases := []model.Release{
+		{
+			Repository: model.NewGithubRepository(model.Identifier(1), repositoryName),
+			Version: semver.Version{
+				Name: repositoryVersion,
 			},
+			Frequency: model.Daily,
+		},
+	}
+
+	emailNotification := model.Notification{ID: 1, Channel: model.NewEmailChannel(user), Releases: releases}
+	otherNotification := model.Notification{ID: 2, Channel: model.NewEmailChannel(model.User{ID: 2, Email: "other@localhost"}), Releases: releases}
+	discordNotification := model.Notification{ID: 3, Channel: model.Channel{ID: 1, Kind: model.Discord, User: user}, Releases: releases}
+
+	cases := map[string]struct {
+		notifications []model.Notification
+		want          model.Report
+		wantErr       error
+	}{
+		"delivered": {
+			[]model.Notification{emailNotification},
+			model.Report{Delivered: 1},
+			nil,
+		},
+		"retried": {
+			[]model.Notification{emailNotification, otherNotification},
+			model.Report{Delivered: 2},
+			nil,
+		},
+		"failed": {
+			[]model.Notification{emailNotification, otherNotification},
+			model.Report{Delivered: 1, DeliveryFailures: 1},
+			errors.New("send Email notification to id=1,email=`nobody@localhost`: failed"),
+		},
+		"backoff limit": {
+			[]model.Notification{emailNotification, otherNotification},
+			model.Report{Delivered: 1, DeliveryFailures: 1},
+			errors.New("send Email notification to id=1,email=`nobody@localhost`: failed"),
+		},
+		"permanent": {
+			[]model.Notification{emailNotification},
+			model.Report{DeliveryFailures: 1},
+			errors.New("send Email notification to id=1,email=`nobody@localhost`: rejected: permanent failure"),
+		},
+		"not configured": {
+			[]model.Notification{discordNotification},
+			model.Report{DeliveryFailures: 1},
+			errors.New("send Discord notification to id=1,email=`nobody@localhost`: Discord channel is not configured"),
+		},
+		"update error": {
+			[]model.Notification{emailNotification, otherNotification},
+			model.Report{Delivered: 2},
 			nil,
 		},
 	}
@@ -456,22 +953,54 @@ func TestSendNotification(t *testing.T) {
 
 			ctrl := gomock.NewController(t)
 
-			mailerService := mocks.NewMailer(ctrl)
-			testCase.instance.mailer = mailerService
+			mockEmail := mocks.NewNotifier(ctrl)
+			mockNotificationService := mocks.NewNotificationService(ctrl)
+
+			instance := Service{
+				notification: mockNotificationService,
+				notifiers: map[model.ChannelKind]model.Notifier{
+					model.Email: mockEmail,
+				},
+				retry: 1,
+			}
 
 			switch intention {
-			case "no mailer":
-				testCase.instance.mailer = nil
-			case "mailer disabled":
-				mailerService.EXPECT().Enabled().Return(false)
-			case "mailer error":
-				mailerService.EXPECT().Enabled().Return(true)
-				mailerService.EXPECT().Send(gomock.Any(), gomock.Any()).Return(errors.New("invalid context"))
-			case "multiple releases":
-				mailerService.EXPECT().Enabled().Return(false)
+			case "delivered":
+				mockEmail.EXPECT().Send(gomock.Any(), emailNotification.Channel, releases).Return(nil)
+				mockNotificationService.EXPECT().Update(gomock.Any(), model.Notification{ID: 1, Channel: emailNotification.Channel, Releases: releases, Status: model.Delivered, Attempts: 1}).Return(nil)
+			case "retried":
+				gomock.InOrder(
+					mockEmail.EXPECT().Send(gomock.Any(), emailNotification.Channel, releases).Return(errors.New("failed")),
+					mockEmail.EXPECT().Send(gomock.Any(), otherNotification.Channel, releases).Return(nil),
+					mockEmail.EXPECT().Send(gomock.Any(), emailNotification.Channel, releases).Return(nil),
+				)
+				mockNotificationService.EXPECT().Update(gomock.Any(), model.Notification{ID: 1, Channel: emailNotification.Channel, Releases: releases, Status: model.Delivered, Attempts: 1}).Return(nil)
+				mockNotificationService.EXPECT().Update(gomock.Any(), model.Notification{ID: 2, Channel: otherNotification.Channel, Releases: releases, Status: model.Delivered, Attempts: 1}).Return(nil)
+			case "failed":
+				mockEmail.EXPECT().Send(gomock.Any(), emailNotification.Channel, releases).Return(errors.New("failed")).Times(2)
+				mockEmail.EXPECT().Send(gomock.Any(), otherNotification.Channel, releases).Return(nil)
+				mockNotificationService.EXPECT().Update(gomock.Any(), model.Notification{ID: 1, Channel: emailNotification.Channel, Releases: releases, Status: model.Failed, Attempts: 1, Error: "failed"}).Return(nil)
+				mockNotificationService.EXPECT().Update(gomock.Any(), model.Notification{ID: 2, Channel: otherNotification.Channel, Releases: releases, Status: model.Delivered, Attempts: 1}).Return(nil)
+			case "backoff limit":
+				instance.backoff = time.Hour
+				instance.backoffLimit = time.Minute
+
+				mockEmail.EXPECT().Send(gomock.Any(), emailNotification.Channel, releases).Return(errors.New("failed"))
+				mockEmail.EXPECT().Send(gomock.Any(), otherNotification.Channel, releases).Return(nil)
+				mockNotificationService.EXPECT().Update(gomock.Any(), model.Notification{ID: 1, Channel: emailNotification.Channel, Releases: releases, Status: model.Failed, Attempts: 1, Error: "failed"}).Return(nil)
+				mockNotificationService.EXPECT().Update(gomock.Any(), model.Notification{ID: 2, Channel: otherNotification.Channel, Releases: releases, Status: model.Delivered, Attempts: 1}).Return(nil)
+			case "permanent":
+				mockEmail.EXPECT().Send(gomock.Any(), emailNotification.Channel, releases).Return(fmt.Errorf("rejected: %w", model.ErrPermanent))
+				mockNotificationService.EXPECT().Update(gomock.Any(), model.Notification{ID: 1, Channel: emailNotification.Channel, Releases: releases, Status: model.Failed, Attempts: model.MaxNotificationAttempts, Error: "rejected: permanent failure"}).Return(nil)
+			case "not configured":
+				mockNotificationService.EXPECT().Update(gomock.Any(), model.Notification{ID: 3, Channel: discordNotification.Channel, Releases: releases, Status: model.Failed, Attempts: 1, Error: "Discord channel is not configured"}).Return(nil)
+			case "update error":
+				mockEmail.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(2)
+				mockNotificationService.EXPECT().Update(gomock.Any(), gomock.Any()).Return(errors.New("failed")).Times(2)
 			}
 
-			gotErr := testCase.instance.sendNotification(testCase.args.ctx, "ketchup", testCase.args.ketchupToNotify, testCase.args.userStatuses)
+			var got model.Report
+			gotErr := instance.deliverNotifications(context.TODO(), &got, testCase.notifications)
 
 			failed := false
 
@@ -481,10 +1010,12 @@ func TestSendNotification(t *testing.T) {
 				failed = true
 			} else if testCase.wantErr != nil && !strings.Contains(gotErr.Error(), testCase.wantErr.Error()) {
 				failed = true
+			} else if !reflect.DeepEqual(got, testCase.want) {
+				failed = true
 			}
 
 			if failed {
-				t.Errorf("sendNotification() = `%s`, want `%s`", gotErr, testCase.wantErr)
+				t.Errorf("deliverNotifications() = (%+v, `%s`), want (%+v, `%s`)", got, gotErr, testCase.want, testCase.wantErr)
 			}
 		})
 	}
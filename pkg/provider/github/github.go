@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
@@ -28,22 +29,22 @@ var (
 	})
 )
 
-type Redis interface {
-	Ping(context.Context) error
-	Exclusive(context.Context, string, time.Duration, func(context.Context) error) (bool, error)
-}
-
 type Tag struct {
 	Name string `json:"name"`
 }
 
+type Release struct {
+	PublishedAt time.Time `json:"published_at"`
+	Body        string    `json:"body"`
+}
+
 type Config struct {
 	Token string
 }
 
 type Service struct {
 	traceProvider trace.TracerProvider
-	redis         Redis
+	redis         model.Redis
 	token         string
 }
 
@@ -55,7 +56,7 @@ func Flags(fs *flag.FlagSet, prefix string) *Config {
 	return &config
 }
 
-func New(config *Config, redisClient Redis, meterProvider metric.MeterProvider, traceProvider trace.TracerProvider) Service {
+func New(config *Config, redisClient model.Redis, meterProvider metric.MeterProvider, traceProvider trace.TracerProvider) Service {
 	httpClient = telemetry.AddOpenTelemetryToClient(httpClient, meterProvider, traceProvider)
 
 	return Service{
@@ -113,6 +114,28 @@ func (s Service) LatestVersions(ctx context.Context, repository string, patterns
 	return versions, nil
 }
 
+// ReleaseDetail gives the publication date and notes of the GitHub release of a tag, empty for a tag without release
+func (s Service) ReleaseDetail(ctx context.Context, repository, version string) (model.ReleaseDetail, error) {
+	resp, err := s.newClient().Get(fmt.Sprintf("%s/repos/%s/releases/tags/%s", apiURL, repository, url.PathEscape(version))).Send(ctx, nil)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return model.ReleaseDetail{}, nil
+		}
+
+		return model.ReleaseDetail{}, fmt.Errorf("get release `%s`: %w", version, err)
+	}
+
+	release, err := httpjson.Read[Release](resp)
+	if err != nil {
+		return model.ReleaseDetail{}, fmt.Errorf("read release `%s`: %w", version, err)
+	}
+
+	return model.ReleaseDetail{
+		PublishedAt: release.PublishedAt,
+		Notes:       release.Body,
+	}, nil
+}
+
 func hasNext(resp *http.Response) bool {
 	for _, value := range resp.Header.Values("Link") {
 		if strings.Contains(value, `rel="next"`) {
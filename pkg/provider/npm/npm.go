@@ -14,10 +14,12 @@ const registryURL = "https://registry.npmjs.org/"
 
 type packageResp struct {
 	Versions map[string]versionResp `json:"versions"`
+	DistTags map[string]string      `json:"dist-tags"`
 }
 
 type versionResp struct {
-	Version string `json:"version"`
+	Version    string `json:"version"`
+	Deprecated string `json:"deprecated"`
 }
 
 type Service struct{}
@@ -27,29 +29,73 @@ func New() Service {
 }
 
 func (s Service) LatestVersions(ctx context.Context, name string, patterns []string) (map[string]semver.Version, error) {
+	versions, _, err := s.Versions(ctx, name, patterns)
+	return versions, err
+}
+
+// Versions returns the latest versions of the patterns and the deprecated ones, from a single fetch of the packument
+func (s Service) Versions(ctx context.Context, name string, patterns []string) (map[string]semver.Version, []string, error) {
 	versions, compiledPatterns, err := model.PreparePatternMatching(patterns)
 	if err != nil {
-		return nil, fmt.Errorf("prepare pattern matching: %w", err)
+		return nil, nil, fmt.Errorf("prepare pattern matching: %w", err)
 	}
 
-	resp, err := request.Get(fmt.Sprintf("%s/%s", registryURL, name)).Header("Accept", "application/vnd.npm.install-v1+json").Send(ctx, nil)
+	content, err := s.fetch(ctx, name)
 	if err != nil {
-		return nil, fmt.Errorf("fetch registry: %w", err)
+		return nil, nil, err
 	}
 
-	content, err := httpjson.Read[packageResp](resp)
-	if err != nil {
-		return nil, fmt.Errorf("read versions: %w", err)
-	}
+	var yanked []string
 
 	for _, version := range content.Versions {
+		if len(version.Deprecated) != 0 {
+			yanked = append(yanked, version.Version)
+		}
+
 		tagVersion, err := semver.Parse(version.Version, semver.ExtractName(name))
 		if err != nil {
 			continue
 		}
 
+		if len(version.Deprecated) != 0 {
+			tagVersion = tagVersion.Yank()
+		}
+
 		model.CheckPatternsMatching(versions, compiledPatterns, tagVersion)
 	}
 
-	return versions, nil
+	for pattern, compiledPattern := range compiledPatterns {
+		tag, ok := compiledPattern.DistTag()
+		if !ok {
+			continue
+		}
+
+		taggedVersion, ok := content.DistTags[tag]
+		if !ok {
+			continue
+		}
+
+		tagVersion, err := semver.Parse(taggedVersion, semver.ExtractName(name))
+		if err != nil {
+			continue
+		}
+
+		versions[pattern] = tagVersion
+	}
+
+	return versions, yanked, nil
+}
+
+func (s Service) fetch(ctx context.Context, name string) (packageResp, error) {
+	resp, err := request.Get(fmt.Sprintf("%s/%s", registryURL, name)).Header("Accept", "application/vnd.npm.install-v1+json").Send(ctx, nil)
+	if err != nil {
+		return packageResp{}, fmt.Errorf("fetch registry: %w", err)
+	}
+
+	content, err := httpjson.Read[packageResp](resp)
+	if err != nil {
+		return packageResp{}, fmt.Errorf("read versions: %w", err)
+	}
+
+	return content, nil
 }
@@ -13,7 +13,11 @@ import (
 const registryURL = "https://pypi.org/pypi"
 
 type packageResp struct {
-	Versions map[string]any `json:"releases"`
+	Versions map[string][]fileResp `json:"releases"`
+}
+
+type fileResp struct {
+	Yanked bool `json:"yanked"`
 }
 
 type Service struct{}
@@ -23,29 +27,70 @@ func New() Service {
 }
 
 func (s Service) LatestVersions(ctx context.Context, name string, patterns []string) (map[string]semver.Version, error) {
+	versions, _, err := s.Versions(ctx, name, patterns)
+	return versions, err
+}
+
+// Versions returns the latest versions of the patterns and the yanked ones, from a single fetch of the project
+func (s Service) Versions(ctx context.Context, name string, patterns []string) (map[string]semver.Version, []string, error) {
 	versions, compiledPatterns, err := model.PreparePatternMatching(patterns)
 	if err != nil {
-		return nil, fmt.Errorf("prepare pattern matching: %w", err)
+		return nil, nil, fmt.Errorf("prepare pattern matching: %w", err)
 	}
 
-	resp, err := request.Get(fmt.Sprintf("%s/%s/json", registryURL, name)).Send(ctx, nil)
+	content, err := s.fetch(ctx, name)
 	if err != nil {
-		return nil, fmt.Errorf("fetch registry: %w", err)
+		return nil, nil, err
 	}
 
-	content, err := httpjson.Read[packageResp](resp)
-	if err != nil {
-		return nil, fmt.Errorf("read versions: %w", err)
-	}
+	var yanked []string
 
-	for version := range content.Versions {
-		tagVersion, err := semver.Parse(version, semver.ExtractName(name))
+	for version, files := range content.Versions {
+		isVersionYanked := isYanked(files)
+		if isVersionYanked {
+			yanked = append(yanked, version)
+		}
+
+		tagVersion, err := semver.ParsePEP440(version)
 		if err != nil {
 			continue
 		}
 
+		if isVersionYanked {
+			tagVersion = tagVersion.Yank()
+		}
+
 		model.CheckPatternsMatching(versions, compiledPatterns, tagVersion)
 	}
 
-	return versions, nil
+	return versions, yanked, nil
+}
+
+func (s Service) fetch(ctx context.Context, name string) (packageResp, error) {
+	resp, err := request.Get(fmt.Sprintf("%s/%s/json", registryURL, name)).Send(ctx, nil)
+	if err != nil {
+		return packageResp{}, fmt.Errorf("fetch registry: %w", err)
+	}
+
+	content, err := httpjson.Read[packageResp](resp)
+	if err != nil {
+		return packageResp{}, fmt.Errorf("read versions: %w", err)
+	}
+
+	return content, nil
+}
+
+// isYanked considers a release yanked when every uploaded file is, as pip does
+func isYanked(files []fileResp) bool {
+	if len(files) == 0 {
+		return false
+	}
+
+	for _, file := range files {
+		if !file.Yanked {
+			return false
+		}
+	}
+
+	return true
 }
@@ -0,0 +1,159 @@
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrInvalidCron = errors.New("invalid cron expression")
+
+// Schedule is a standard five fields cron expression: minute, hour, day of month, month and day of week
+type Schedule struct {
+	minutes    uint64
+	hours      uint64
+	days       uint64
+	months     uint64
+	weekdays   uint64
+	anyDay     bool
+	anyWeekday bool
+}
+
+type bounds struct {
+	min uint
+	max uint
+}
+
+var fieldsBounds = [5]bounds{{0, 59}, {0, 23}, {1, 31}, {1, 12}, {0, 7}}
+
+func ParseCron(expression string) (Schedule, error) {
+	fields := strings.Fields(expression)
+	if len(fields) != len(fieldsBounds) {
+		return Schedule{}, fmt.Errorf("%w: %d fields instead of %d", ErrInvalidCron, len(fields), len(fieldsBounds))
+	}
+
+	var values [5]uint64
+
+	for index, field := range fields {
+		value, err := parseField(field, fieldsBounds[index])
+		if err != nil {
+			return Schedule{}, fmt.Errorf("%w: field `%s`: %w", ErrInvalidCron, field, err)
+		}
+
+		values[index] = value
+	}
+
+	weekdays := values[4]
+	if weekdays&(1<<7) != 0 {
+		weekdays |= 1
+	}
+
+	return Schedule{
+		minutes:    values[0],
+		hours:      values[1],
+		days:       values[2],
+		months:     values[3],
+		weekdays:   weekdays,
+		anyDay:     strings.HasPrefix(fields[2], "*"),
+		anyWeekday: strings.HasPrefix(fields[4], "*"),
+	}, nil
+}
+
+func parseField(field string, limits bounds) (uint64, error) {
+	var output uint64
+
+	for part := range strings.SplitSeq(field, ",") {
+		rangePart, rawStep, hasStep := strings.Cut(part, "/")
+
+		step := uint64(1)
+		if hasStep {
+			value, err := strconv.ParseUint(rawStep, 10, 8)
+			if err != nil || value == 0 {
+				return 0, fmt.Errorf("invalid step `%s`", rawStep)
+			}
+
+			step = value
+		}
+
+		start, end := limits.min, limits.max
+
+		if rangePart != "*" {
+			rawStart, rawEnd, isRange := strings.Cut(rangePart, "-")
+
+			value, err := parseValue(rawStart, limits)
+			if err != nil {
+				return 0, err
+			}
+			start, end = value, value
+
+			if isRange {
+				if end, err = parseValue(rawEnd, limits); err != nil {
+					return 0, err
+				}
+			} else if hasStep {
+				end = limits.max
+			}
+
+			if end < start {
+				return 0, fmt.Errorf("invalid range `%s`", rangePart)
+			}
+		}
+
+		for value := uint64(start); value <= uint64(end); value += step {
+			output |= 1 << value
+		}
+	}
+
+	return output, nil
+}
+
+func parseValue(raw string, limits bounds) (uint, error) {
+	value, err := strconv.ParseUint(raw, 10, 8)
+	if err != nil || uint(value) < limits.min || uint(value) > limits.max {
+		return 0, fmt.Errorf("value `%s` out of [%d-%d]", raw, limits.min, limits.max)
+	}
+
+	return uint(value), nil
+}
+
+// Next gives the first time strictly after the given one matching the schedule, in the location of the given time
+func (s Schedule) Next(from time.Time) time.Time {
+	next := from.Truncate(time.Minute).Add(time.Minute)
+	limit := next.AddDate(5, 0, 0)
+
+	for next.Before(limit) {
+		switch {
+		case s.months&(1<<uint(next.Month())) == 0:
+			next = time.Date(next.Year(), next.Month()+1, 1, 0, 0, 0, 0, next.Location())
+		case !s.matchDay(next):
+			next = time.Date(next.Year(), next.Month(), next.Day()+1, 0, 0, 0, 0, next.Location())
+		case s.hours&(1<<uint(next.Hour())) == 0:
+			next = time.Date(next.Year(), next.Month(), next.Day(), next.Hour()+1, 0, 0, 0, next.Location())
+		case s.minutes&(1<<uint(next.Minute())) == 0:
+			next = next.Add(time.Minute)
+		default:
+			return next
+		}
+	}
+
+	return time.Time{}
+}
+
+// matchDay follows cron: when both day of month and day of week are restricted, matching either is enough
+func (s Schedule) matchDay(date time.Time) bool {
+	day := s.days&(1<<uint(date.Day())) != 0
+	weekday := s.weekdays&(1<<uint(date.Weekday())) != 0
+
+	switch {
+	case s.anyDay && s.anyWeekday:
+		return true
+	case s.anyDay:
+		return weekday
+	case s.anyWeekday:
+		return day
+	default:
+		return day || weekday
+	}
+}
@@ -0,0 +1,136 @@
+package scheduler
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNext(t *testing.T) {
+	t.Parallel()
+
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Fatalf("load location: %s", err)
+	}
+
+	// Monday
+	from := time.Date(2026, 10, 19, 8, 30, 15, 0, time.UTC)
+
+	cases := map[string]struct {
+		expression string
+		from       time.Time
+		want       time.Time
+		wantErr    error
+	}{
+		"every hour": {
+			"0 * * * *",
+			from,
+			time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC),
+			nil,
+		},
+		"every minute": {
+			"* * * * *",
+			from,
+			time.Date(2026, 10, 19, 8, 31, 0, 0, time.UTC),
+			nil,
+		},
+		"step": {
+			"*/20 * * * *",
+			from,
+			time.Date(2026, 10, 19, 8, 40, 0, 0, time.UTC),
+			nil,
+		},
+		"list and range": {
+			"15 6,10-12 * * *",
+			from,
+			time.Date(2026, 10, 19, 10, 15, 0, 0, time.UTC),
+			nil,
+		},
+		"next day": {
+			"0 8 * * *",
+			from,
+			time.Date(2026, 10, 20, 8, 0, 0, 0, time.UTC),
+			nil,
+		},
+		"weekday": {
+			"0 8 * * 0",
+			from,
+			time.Date(2026, 10, 25, 8, 0, 0, 0, time.UTC),
+			nil,
+		},
+		"sunday as 7": {
+			"0 8 * * 7",
+			from,
+			time.Date(2026, 10, 25, 8, 0, 0, 0, time.UTC),
+			nil,
+		},
+		"day of month or weekday": {
+			"0 8 1 * 3",
+			from,
+			time.Date(2026, 10, 21, 8, 0, 0, 0, time.UTC),
+			nil,
+		},
+		"next year": {
+			"0 0 1 1 *",
+			from,
+			time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC),
+			nil,
+		},
+		"location": {
+			"0 8 * * *",
+			time.Date(2026, 10, 19, 7, 30, 0, 0, paris),
+			time.Date(2026, 10, 19, 8, 0, 0, 0, paris),
+			nil,
+		},
+		"never": {
+			"0 0 31 2 *",
+			from,
+			time.Time{},
+			nil,
+		},
+		"missing field": {
+			"0 * * *",
+			from,
+			time.Time{},
+			ErrInvalidCron,
+		},
+		"out of range": {
+			"60 * * * *",
+			from,
+			time.Time{},
+			ErrInvalidCron,
+		},
+		"invalid step": {
+			"*/0 * * * *",
+			from,
+			time.Time{},
+			ErrInvalidCron,
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			schedule, gotErr := ParseCron(testCase.expression)
+
+			var got time.Time
+			if gotErr == nil {
+				got = schedule.Next(testCase.from)
+			}
+
+			failed := false
+
+			if !errors.Is(gotErr, testCase.wantErr) {
+				failed = true
+			} else if !got.Equal(testCase.want) {
+				failed = true
+			}
+
+			if failed {
+				t.Errorf("Next() = (%s, `%s`), want (%s, `%s`)", got, gotErr, testCase.want, testCase.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package scheduler
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/ViBiOh/flags"
+	"github.com/ViBiOh/ketchup/pkg/model"
+)
+
+const lockName = "ketchup:notifier"
+
+type Service struct {
+	redis    model.Redis
+	location *time.Location
+	clock    func() time.Time
+	schedule Schedule
+	timeout  time.Duration
+	enabled  bool
+}
+
+type Config struct {
+	Cron     string
+	Timezone string
+	Timeout  time.Duration
+}
+
+func Flags(fs *flag.FlagSet, prefix string) *Config {
+	var config Config
+
+	flags.New("Cron", "Cron expression of the in-process notifier, disabled if empty, immediate notifications requiring it along with -checkerBackground").Prefix(prefix).DocPrefix("scheduler").StringVar(fs, &config.Cron, "", nil)
+	flags.New("Timezone", "Timezone of the cron expression").Prefix(prefix).DocPrefix("scheduler").StringVar(fs, &config.Timezone, "Europe/Paris", nil)
+	flags.New("Timeout", "Maximum duration of a run, the lock across instances being released after it").Prefix(prefix).DocPrefix("scheduler").DurationVar(fs, &config.Timeout, time.Hour, nil)
+
+	return &config
+}
+
+func New(config *Config, redis model.Redis) (Service, error) {
+	if len(config.Cron) == 0 {
+		return Service{}, nil
+	}
+
+	schedule, err := ParseCron(config.Cron)
+	if err != nil {
+		return Service{}, err
+	}
+
+	location, err := time.LoadLocation(config.Timezone)
+	if err != nil {
+		return Service{}, fmt.Errorf("load timezone `%s`: %w", config.Timezone, err)
+	}
+
+	return Service{
+		redis:    redis,
+		location: location,
+		clock:    time.Now,
+		schedule: schedule,
+		timeout:  config.Timeout,
+		enabled:  true,
+	}, nil
+}
+
+func (s Service) Enabled() bool {
+	return s.enabled
+}
+
+// Start runs the action on each occurrence of the schedule until the context is done, only one instance running it at a time
+func (s Service) Start(ctx context.Context, action func(context.Context) error) {
+	if !s.enabled {
+		return
+	}
+
+	for {
+		next := s.schedule.Next(s.clock().In(s.location))
+		if next.IsZero() {
+			slog.LogAttrs(ctx, slog.LevelWarn, "no next occurrence of the schedule")
+			return
+		}
+
+		slog.LogAttrs(ctx, slog.LevelInfo, "Next notifier run", slog.Time("at", next))
+
+		timer := time.NewTimer(time.Until(next))
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		s.Run(ctx, action)
+	}
+}
+
+// Run runs the action right away, unless another instance is already running it
+func (s Service) Run(ctx context.Context, action func(context.Context) error) {
+	acquired, err := s.redis.Exclusive(ctx, lockName, s.timeout, func(ctx context.Context) error {
+		ctx, cancel := context.WithTimeout(ctx, s.timeout)
+		defer cancel()
+
+		return action(ctx)
+	})
+
+	switch {
+	case err != nil:
+		slog.LogAttrs(ctx, slog.LevelError, "notifier run", slog.Any("error", err))
+	case !acquired:
+		slog.LogAttrs(ctx, slog.LevelInfo, "Notifier already running on another instance")
+	}
+}
@@ -0,0 +1,62 @@
+package scheduler
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		config      Config
+		wantEnabled bool
+		wantErr     error
+	}{
+		"disabled": {
+			Config{Timezone: "Europe/Paris"},
+			false,
+			nil,
+		},
+		"daily": {
+			Config{Cron: "0 8 * * *", Timezone: "Europe/Paris"},
+			true,
+			nil,
+		},
+		"invalid cron": {
+			Config{Cron: "0 25 * * *", Timezone: "Europe/Paris"},
+			false,
+			ErrInvalidCron,
+		},
+		"invalid timezone": {
+			Config{Cron: "0 8 * * *", Timezone: "Europe/Nowhere"},
+			false,
+			errors.New("load timezone `Europe/Nowhere`"),
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotErr := New(&testCase.config, nil)
+
+			failed := false
+
+			if testCase.wantErr == nil && gotErr != nil {
+				failed = true
+			} else if testCase.wantErr != nil && gotErr == nil {
+				failed = true
+			} else if testCase.wantErr != nil && !errors.Is(gotErr, testCase.wantErr) && !strings.Contains(gotErr.Error(), testCase.wantErr.Error()) {
+				failed = true
+			} else if got.Enabled() != testCase.wantEnabled {
+				failed = true
+			}
+
+			if failed {
+				t.Errorf("New() = (%t, `%s`), want (%t, `%s`)", got.Enabled(), gotErr, testCase.wantEnabled, testCase.wantErr)
+			}
+		})
+	}
+}
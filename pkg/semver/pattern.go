@@ -3,8 +3,12 @@ package semver
 import (
 	"errors"
 	"fmt"
+	"strings"
 )
 
+// DistTagPrefix prefixes a pattern resolved from a registry tag instead of version constraints, e.g. `dist-tag:next`
+const DistTagPrefix = "dist-tag:"
+
 var ErrPatternInvalid = errors.New("pattern is invalid")
 
 type operation int
@@ -28,6 +32,7 @@ func newConstraint(version Version, comparator operation) constraint {
 
 type Pattern struct {
 	Name        string
+	distTag     string
 	constraints []constraint
 }
 
@@ -38,6 +43,10 @@ func NewPattern(name string, constraints ...constraint) Pattern {
 	}
 }
 
+func (p Pattern) DistTag() (string, bool) {
+	return p.distTag, len(p.distTag) != 0
+}
+
 func (p Pattern) Check(version Version) bool {
 	for _, constraint := range p.constraints {
 		if constraint.version.suffix == -1 && version.suffix != -1 {
@@ -64,6 +73,14 @@ func ParsePattern(pattern string) (Pattern, error) {
 		return Pattern{}, ErrPatternInvalid
 	}
 
+	if tag, ok := strings.CutPrefix(pattern, DistTagPrefix); ok {
+		if len(strings.TrimSpace(tag)) == 0 {
+			return Pattern{}, ErrPatternInvalid
+		}
+
+		return Pattern{Name: pattern, distTag: tag}, nil
+	}
+
 	if pattern == "latest" {
 		return NewPattern(pattern, newConstraint(safeParse("0.0-0"), greaterOrEqual)), nil
 	}
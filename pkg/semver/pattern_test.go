@@ -64,6 +64,13 @@ func TestCheck(t *testing.T) {
 			},
 			false,
 		},
+		"stable unknown prerelease": {
+			safeParsePattern("stable"),
+			args{
+				version: safeParse("1.0.0-nightly.20240101"),
+			},
+			false,
+		},
 		"simple caret": {
 			safeParsePattern("^2"),
 			args{
@@ -176,6 +183,13 @@ func TestCheck(t *testing.T) {
 			},
 			false,
 		},
+		"dist-tag": {
+			safeParsePattern("dist-tag:next"),
+			args{
+				version: safeParse(betaVersion),
+			},
+			true,
+		},
 	}
 
 	for intention, testCase := range cases {
@@ -0,0 +1,136 @@
+package semver
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// pep440Dev ranks the development releases of PEP 440 (`.devN`) below any pre-release
+const pep440Dev NonFinalVersion = -2
+
+var (
+	// According to https://packaging.python.org/en/latest/specifications/version-specifiers/#appendix-parsing-version-strings-with-regular-expressions
+	pep440Matcher    = regexp.MustCompile(`(?i)^v?(?:(?P<epoch>[0-9]+)!)?(?P<release>[0-9]+(?:\.[0-9]+)*)(?:[-_.]?(?P<pre_l>alpha|a|beta|b|preview|pre|c|rc)[-_.]?(?P<pre_n>[0-9]+)?)?(?:-(?P<post_n1>[0-9]+)|[-_.]?(?P<post_l>post|rev|r)[-_.]?(?P<post_n2>[0-9]+)?)?(?:[-_.]?(?P<dev_l>dev)[-_.]?(?P<dev_n>[0-9]+)?)?(?:\+(?P<local>[a-z0-9]+(?:[-_.][a-z0-9]+)*))?$`)
+	pep440MatchNames = pep440Matcher.SubexpNames()
+
+	pep440PreReleases = map[string]NonFinalVersion{
+		"a":       alpha,
+		"alpha":   alpha,
+		"b":       beta,
+		"beta":    beta,
+		"c":       rc,
+		"rc":      rc,
+		"pre":     rc,
+		"preview": rc,
+	}
+)
+
+// ParsePEP440 parses a Python package version, mapping pre-releases and development releases to non final versions and post-releases to final ones
+func ParsePEP440(version string) (Version, error) {
+	matches := pep440Matcher.FindStringSubmatch(version)
+	if len(matches) == 0 {
+		return Version{}, fmt.Errorf("parse pep440 version: %s", version)
+	}
+
+	values := make(map[string]string)
+	for index, name := range pep440MatchNames {
+		if index != 0 {
+			values[name] = strings.ToLower(matches[index])
+		}
+	}
+
+	output := Version{
+		Name:   version,
+		suffix: -1,
+	}
+
+	var err error
+
+	if len(values["epoch"]) != 0 {
+		if output.epoch, err = strconv.ParseUint(values["epoch"], 10, 64); err != nil {
+			return Version{}, fmt.Errorf("version epoch is not numeric")
+		}
+	}
+
+	if err = output.setRelease(strings.Split(values["release"], ".")); err != nil {
+		return Version{}, fmt.Errorf("%w: %s", err, version)
+	}
+
+	if preRelease := values["pre_l"]; len(preRelease) != 0 {
+		output.suffix = pep440PreReleases[preRelease]
+
+		if output.pre, err = parseOptionalNumber(values["pre_n"]); err != nil {
+			return Version{}, fmt.Errorf("version pre-release is not numeric")
+		}
+	}
+
+	if len(values["post_n1"]) != 0 || len(values["post_l"]) != 0 {
+		post, err := parseOptionalNumber(values["post_n1"] + values["post_n2"])
+		if err != nil {
+			return Version{}, fmt.Errorf("version post-release is not numeric")
+		}
+
+		output.post = post + 1
+	}
+
+	if len(values["dev_l"]) != 0 {
+		devNumber, err := parseOptionalNumber(values["dev_n"])
+		if err != nil {
+			return Version{}, fmt.Errorf("version development release is not numeric")
+		}
+
+		output.dev = devNumber + 1
+
+		if output.suffix == -1 {
+			output.suffix = pep440Dev
+		}
+	}
+
+	return output, nil
+}
+
+func (v *Version) setRelease(segments []string) error {
+	if len(segments[0]) >= 8 {
+		return fmt.Errorf("version major looks like a date")
+	}
+
+	numbers := make([]uint64, len(segments))
+	for index, segment := range segments {
+		number, err := strconv.ParseUint(segment, 10, 64)
+		if err != nil {
+			return fmt.Errorf("version segment is not numeric")
+		}
+
+		numbers[index] = number
+	}
+
+	if numbers[0] > maxVersionNumber {
+		return fmt.Errorf("version major seems a bit high")
+	}
+
+	v.major = numbers[0]
+
+	if len(numbers) > 1 {
+		v.minor = numbers[1]
+	}
+
+	if len(numbers) > 2 {
+		v.patch = numbers[2]
+	}
+
+	if len(numbers) > 3 {
+		v.revisions = numbers[3:]
+	}
+
+	return nil
+}
+
+func parseOptionalNumber(value string) (uint64, error) {
+	if len(value) == 0 {
+		return 0, nil
+	}
+
+	return strconv.ParseUint(value, 10, 64)
+}
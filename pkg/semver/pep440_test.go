@@ -0,0 +1,235 @@
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParsePEP440(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		version string
+	}
+
+	cases := map[string]struct {
+		args    args
+		want    Version
+		wantErr error
+	}{
+		"invalid": {
+			args{
+				version: "release.r60.1",
+			},
+			Version{},
+			errors.New("parse pep440 version"),
+		},
+		"final": {
+			args{
+				version: "2.31.0",
+			},
+			Version{Name: "2.31.0", major: 2, minor: 31, suffix: -1},
+			nil,
+		},
+		"four components": {
+			args{
+				version: "1.2.3.4",
+			},
+			Version{Name: "1.2.3.4", revisions: []uint64{4}, major: 1, minor: 2, patch: 3, suffix: -1},
+			nil,
+		},
+		"epoch": {
+			args{
+				version: "1!2.0",
+			},
+			Version{Name: "1!2.0", epoch: 1, major: 2, suffix: -1},
+			nil,
+		},
+		"release candidate": {
+			args{
+				version: "2.0rc1",
+			},
+			Version{Name: "2.0rc1", major: 2, pre: 1, suffix: rc},
+			nil,
+		},
+		"alternative spelling": {
+			args{
+				version: "1.0-Alpha.2",
+			},
+			Version{Name: "1.0-Alpha.2", major: 1, pre: 2, suffix: alpha},
+			nil,
+		},
+		"post release": {
+			args{
+				version: "1.0.post1",
+			},
+			Version{Name: "1.0.post1", major: 1, post: 2, suffix: -1},
+			nil,
+		},
+		"implicit post release": {
+			args{
+				version: "1.0-1",
+			},
+			Version{Name: "1.0-1", major: 1, post: 2, suffix: -1},
+			nil,
+		},
+		"dev release": {
+			args{
+				version: "1.0.dev3",
+			},
+			Version{Name: "1.0.dev3", major: 1, dev: 4, suffix: pep440Dev},
+			nil,
+		},
+		"pre dev release": {
+			args{
+				version: "1.0b2.dev1",
+			},
+			Version{Name: "1.0b2.dev1", major: 1, pre: 2, dev: 2, suffix: beta},
+			nil,
+		},
+		"local": {
+			args{
+				version: "1.0+ubuntu.1",
+			},
+			Version{Name: "1.0+ubuntu.1", major: 1, suffix: -1},
+			nil,
+		},
+		"date": {
+			args{
+				version: "20160726",
+			},
+			Version{},
+			errors.New("version major looks like a date"),
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotErr := ParsePEP440(testCase.args.version)
+
+			failed := false
+
+			if testCase.wantErr == nil && gotErr != nil {
+				failed = true
+			} else if testCase.wantErr != nil && gotErr == nil {
+				failed = true
+			} else if testCase.wantErr != nil && !strings.Contains(gotErr.Error(), testCase.wantErr.Error()) {
+				failed = true
+			} else if !reflect.DeepEqual(got, testCase.want) {
+				failed = true
+			}
+
+			if failed {
+				t.Errorf("ParsePEP440() = (%+v, `%s`), want (%+v, `%s`)", got, gotErr, testCase.want, testCase.wantErr)
+			}
+		})
+	}
+}
+
+func TestPEP440Ordering(t *testing.T) {
+	t.Parallel()
+
+	ordered := []string{
+		"1.0.dev1",
+		"1.0a1.dev1",
+		"1.0a1",
+		"1.0a1.post1",
+		"1.0a2",
+		"1.0b1",
+		"1.0rc1",
+		"1.0rc10",
+		"1.0",
+		"1.0.post1.dev1",
+		"1.0.post1",
+		"1.0.post2",
+		"1.0.1",
+		"1.1.dev1",
+		"1!0.1",
+	}
+
+	for index := 1; index < len(ordered); index++ {
+		lower := safeParsePEP440(ordered[index-1])
+		greater := safeParsePEP440(ordered[index])
+
+		if !greater.IsGreater(lower) {
+			t.Errorf("IsGreater(`%s`, `%s`) = false, want true", ordered[index], ordered[index-1])
+		}
+
+		if lower.IsGreater(greater) {
+			t.Errorf("IsGreater(`%s`, `%s`) = true, want false", ordered[index-1], ordered[index])
+		}
+	}
+}
+
+func TestPEP440Pattern(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		version string
+	}
+
+	cases := map[string]struct {
+		instance Pattern
+		args     args
+		want     bool
+	}{
+		"stable post release": {
+			safeParsePattern("stable"),
+			args{
+				version: "1.0.post1",
+			},
+			true,
+		},
+		"stable release candidate": {
+			safeParsePattern("stable"),
+			args{
+				version: "2.0rc1",
+			},
+			false,
+		},
+		"stable dev release": {
+			safeParsePattern("stable"),
+			args{
+				version: "1.0.post1.dev1",
+			},
+			false,
+		},
+		"latest dev release": {
+			safeParsePattern("latest"),
+			args{
+				version: "1.0.dev3",
+			},
+			true,
+		},
+		"caret post release": {
+			safeParsePattern("^1.0"),
+			args{
+				version: "1.4.post2",
+			},
+			true,
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCase.instance.Check(safeParsePEP440(testCase.args.version)); got != testCase.want {
+				t.Errorf("Check() = %t, want %t", got, testCase.want)
+			}
+		})
+	}
+}
+
+func safeParsePEP440(version string) Version {
+	output, err := ParsePEP440(version)
+	if err != nil {
+		fmt.Println(err)
+	}
+	return output
+}
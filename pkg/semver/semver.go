@@ -1,8 +1,10 @@
 package semver
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
+	"math"
 	"regexp"
 	"slices"
 	"strconv"
@@ -15,17 +17,34 @@ const maxVersionNumber = 1 << 16
 type NonFinalVersion int
 
 type Version struct {
-	Name   string `json:"name"`
-	major  uint64
-	minor  uint64
-	patch  uint64
-	suffix NonFinalVersion
+	Name       string `json:"name"`
+	revisions  []uint64
+	prerelease []string
+	epoch      uint64
+	major      uint64
+	minor      uint64
+	patch      uint64
+	pre        uint64 // pre-release number
+	post       uint64 // post-release number plus one, zero when absent
+	dev        uint64 // development release number plus one, zero when absent
+	suffix     NonFinalVersion
+	yanked     bool // withdrawn by its registry: yanked, deprecated or retracted
 }
 
 func (v Version) IsZero() bool {
 	return len(v.Name) == 0
 }
 
+func (v Version) Yank() Version {
+	v.yanked = true
+
+	return v
+}
+
+func (v Version) IsYanked() bool {
+	return v.yanked
+}
+
 const (
 	alpha NonFinalVersion = iota + 1
 	beta
@@ -37,7 +56,7 @@ const (
 
 var (
 	// According to https://semver.org/#spec-item-11
-	semverMatcher    = regexp.MustCompile(`(?i)^(?P<prefix>[a-zA-Z-]*)(?P<major>[0-9]+)(?:\.(?P<minor>[0-9]+))?(?:\.(?P<patch>[0-9]+))?(?P<prerelease>-[a-zA-Z0-9.]+)?(?P<build>\+[a-zA-Z0-9.]+)?$`)
+	semverMatcher    = regexp.MustCompile(`(?i)^(?P<prefix>[a-zA-Z-]*)(?P<major>[0-9]+)(?:\.(?P<minor>[0-9]+))?(?:\.(?P<patch>[0-9]+))?(?P<revisions>(?:\.[0-9]+)*)(?P<prerelease>-[a-zA-Z0-9.]+)?(?P<build>\+[a-zA-Z0-9.]+)?$`)
 	semverMatchNames = semverMatcher.SubexpNames()
 
 	nonFinalVersions = []string{"alpha", "beta", "canary", "edge", "rc", "test", "preview"}
@@ -50,13 +69,19 @@ var (
 	}
 
 	ErrPrefixInvalid = errors.New("invalid prefix")
+
+	changePriorities = []string{"Major", "Minor", "Patch", "Build", "Suffix", "Version"}
 )
 
 func (v Version) Equals(other Version) bool {
-	return v.major == other.major && v.minor == other.minor && v.patch == other.patch && v.suffix == other.suffix
+	return v.epoch == other.epoch && v.major == other.major && v.minor == other.minor && v.patch == other.patch && compareRevisions(v.revisions, other.revisions) == 0 && comparePrerelease(v.prerelease, other.prerelease) == 0 && v.suffix == other.suffix && v.pre == other.pre && v.post == other.post && v.dev == other.dev
 }
 
 func (v Version) IsGreater(other Version) bool {
+	if v.epoch != other.epoch {
+		return v.epoch > other.epoch
+	}
+
 	if v.major != other.major {
 		return v.major > other.major
 	}
@@ -69,17 +94,47 @@ func (v Version) IsGreater(other Version) bool {
 		return v.patch > other.patch
 	}
 
-	if v.suffix != other.suffix {
-		if v.suffix == -1 {
-			return true
-		}
+	if revisions := compareRevisions(v.revisions, other.revisions); revisions != 0 {
+		return revisions > 0
+	}
 
-		return v.suffix > other.suffix
+	if v.preOrder() != other.preOrder() {
+		return v.preOrder() > other.preOrder()
+	}
+
+	if prerelease := comparePrerelease(v.prerelease, other.prerelease); prerelease != 0 {
+		return prerelease > 0
+	}
+
+	if v.pre != other.pre {
+		return v.pre > other.pre
+	}
+
+	if v.post != other.post {
+		return v.post > other.post
+	}
+
+	if v.dev != other.dev {
+		return v.dev == 0 || (other.dev != 0 && v.dev > other.dev)
 	}
 
 	return v.Name > other.Name
 }
 
+// preOrder ranks the release channel, a final or post release being above any pre-release.
+// Semver pre-releases share the lowest rank and are ordered by their identifiers.
+func (v Version) preOrder() int {
+	if v.suffix == -1 || (v.suffix == pep440Dev && v.post != 0) {
+		return math.MaxInt
+	}
+
+	if len(v.prerelease) != 0 {
+		return 0
+	}
+
+	return int(v.suffix)
+}
+
 func ExtractName(name string) string {
 	parts := strings.Split(name, "/")
 
@@ -87,7 +142,7 @@ func ExtractName(name string) string {
 }
 
 func (v Version) Compare(other Version) string {
-	if v.major != other.major {
+	if v.epoch != other.epoch || v.major != other.major {
 		return "Major"
 	}
 
@@ -99,7 +154,11 @@ func (v Version) Compare(other Version) string {
 		return "Patch"
 	}
 
-	if v.suffix != other.suffix {
+	if compareRevisions(v.revisions, other.revisions) != 0 {
+		return "Build"
+	}
+
+	if v.suffix != other.suffix || comparePrerelease(v.prerelease, other.prerelease) != 0 || v.pre != other.pre || v.post != other.post || v.dev != other.dev {
 		return "Suffix"
 	}
 
@@ -110,6 +169,84 @@ func (v Version) Compare(other Version) string {
 	return ""
 }
 
+// ChangePriority gives the rank of a change returned by Compare, lower is more important
+func ChangePriority(change string) int {
+	if index := slices.Index(changePriorities, change); index != -1 {
+		return index
+	}
+
+	return len(changePriorities)
+}
+
+// compareRevisions compares numeric segments after the patch, a missing segment being zero
+func compareRevisions(first, second []uint64) int {
+	for index := range max(len(first), len(second)) {
+		var firstValue, secondValue uint64
+
+		if index < len(first) {
+			firstValue = first[index]
+		}
+
+		if index < len(second) {
+			secondValue = second[index]
+		}
+
+		if firstValue != secondValue {
+			return cmp.Compare(firstValue, secondValue)
+		}
+	}
+
+	return 0
+}
+
+// comparePrerelease applies https://semver.org/#spec-item-11 on dot-separated identifiers
+func comparePrerelease(first, second []string) int {
+	for index := range min(len(first), len(second)) {
+		if identifier := compareIdentifier(first[index], second[index]); identifier != 0 {
+			return identifier
+		}
+	}
+
+	return cmp.Compare(len(first), len(second))
+}
+
+func compareIdentifier(first, second string) int {
+	firstNumeric := isNumeric(first)
+	secondNumeric := isNumeric(second)
+
+	switch {
+	case firstNumeric && secondNumeric:
+		first = strings.TrimLeft(first, "0")
+		second = strings.TrimLeft(second, "0")
+
+		if len(first) != len(second) {
+			return cmp.Compare(len(first), len(second))
+		}
+
+		return strings.Compare(first, second)
+	case firstNumeric:
+		return -1
+	case secondNumeric:
+		return 1
+	default:
+		return strings.Compare(first, second)
+	}
+}
+
+func isNumeric(identifier string) bool {
+	if len(identifier) == 0 {
+		return false
+	}
+
+	for _, char := range identifier {
+		if char < '0' || char > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
 func parseSemver(version string) (map[string]string, error) {
 	matches := semverMatcher.FindStringSubmatch(version)
 	if len(matches) == 0 {
@@ -141,6 +278,10 @@ func Parse(version, name string) (Version, error) {
 		suffix: parseNonFinalVersion(matches),
 	}
 
+	if prerelease := matches["prerelease"]; len(prerelease) != 0 {
+		semver.prerelease = strings.Split(prerelease[1:], ".")
+	}
+
 	if len(matches["major"]) >= 8 {
 		return Version{}, fmt.Errorf("version major looks like a date: %s", version)
 	}
@@ -168,6 +309,17 @@ func Parse(version, name string) (Version, error) {
 		}
 	}
 
+	if revisions := matches["revisions"]; len(revisions) != 0 {
+		for rawRevision := range strings.SplitSeq(revisions[1:], ".") {
+			revision, err := strconv.ParseUint(rawRevision, 10, 64)
+			if err != nil {
+				return Version{}, fmt.Errorf("version revision is not numeric")
+			}
+
+			semver.revisions = append(semver.revisions, revision)
+		}
+	}
+
 	return semver, nil
 }
 
@@ -2,6 +2,7 @@ package semver
 
 import (
 	"errors"
+	"reflect"
 	"strings"
 	"testing"
 )
@@ -47,23 +48,23 @@ func TestIsGreater(t *testing.T) {
 			true,
 		},
 		"patch with major greater": {
-			Version{"", 2, 0, 1, 0},
+			Version{major: 2, patch: 1},
 			args{
-				other: Version{"", 1, 0, 2, 0},
+				other: Version{major: 1, patch: 2},
 			},
 			true,
 		},
 		"patch with minor greater": {
-			Version{"", 1, 2, 1, 0},
+			Version{major: 1, minor: 2, patch: 1},
 			args{
-				other: Version{"", 1, 1, 2, 0},
+				other: Version{major: 1, minor: 1, patch: 2},
 			},
 			true,
 		},
 		"patch with suffix greater": {
-			Version{"", 1, 1, 1, canary},
+			Version{major: 1, minor: 1, patch: 1, suffix: canary},
 			args{
-				other: Version{"", 1, 1, 1, beta},
+				other: Version{major: 1, minor: 1, patch: 1, suffix: beta},
 			},
 			true,
 		},
@@ -74,6 +75,76 @@ func TestIsGreater(t *testing.T) {
 			},
 			true,
 		},
+		"prerelease numeric identifier": {
+			safeParse("1.0.0-rc.10"),
+			args{
+				other: safeParse("1.0.0-rc.2"),
+			},
+			true,
+		},
+		"prerelease alphanumeric identifier": {
+			safeParse("1.0.0-beta"),
+			args{
+				other: safeParse("1.0.0-alpha.beta"),
+			},
+			true,
+		},
+		"prerelease numeric lower than alphanumeric": {
+			safeParse("1.0.0-alpha.1"),
+			args{
+				other: safeParse("1.0.0-alpha.beta"),
+			},
+			false,
+		},
+		"prerelease more identifiers": {
+			safeParse("1.0.0-alpha.1"),
+			args{
+				other: safeParse("1.0.0-alpha"),
+			},
+			true,
+		},
+		"prerelease lower than release": {
+			safeParse("1.0.0-rc.1"),
+			args{
+				other: safeParse("1.0.0"),
+			},
+			false,
+		},
+		"unknown prerelease lower than release": {
+			safeParse("1.0.0-abcdef"),
+			args{
+				other: safeParse("1.0.0"),
+			},
+			false,
+		},
+		"revision": {
+			safeParse("1.2.3.10"),
+			args{
+				other: safeParse("1.2.3.9"),
+			},
+			true,
+		},
+		"revision presence": {
+			safeParse("1.2.3.1"),
+			args{
+				other: safeParse("1.2.3"),
+			},
+			true,
+		},
+		"revision with patch greater": {
+			safeParse("1.2.4"),
+			args{
+				other: safeParse("1.2.3.4"),
+			},
+			true,
+		},
+		"devel prerelease by identifiers": {
+			safeParse("1.0.0-devel"),
+			args{
+				other: safeParse("1.0.0-alpha"),
+			},
+			true,
+		},
 		"equal": {
 			safeParse("1.0.0"),
 			args{
@@ -94,6 +165,37 @@ func TestIsGreater(t *testing.T) {
 	}
 }
 
+func TestPrereleaseOrdering(t *testing.T) {
+	t.Parallel()
+
+	ordered := []string{
+		"1.0.0-0.3.rc3",
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-alpha.beta",
+		"1.0.0-beta",
+		"1.0.0-beta.2",
+		"1.0.0-beta.11",
+		"1.0.0-rc.1",
+		"1.0.0-rc.2",
+		"1.0.0-rc.10",
+		"1.0.0",
+	}
+
+	for index := 1; index < len(ordered); index++ {
+		lower := safeParse(ordered[index-1])
+		greater := safeParse(ordered[index])
+
+		if !greater.IsGreater(lower) {
+			t.Errorf("IsGreater(`%s`, `%s`) = false, want true", ordered[index], ordered[index-1])
+		}
+
+		if lower.IsGreater(greater) {
+			t.Errorf("IsGreater(`%s`, `%s`) = true, want false", ordered[index-1], ordered[index])
+		}
+	}
+}
+
 func TestCompare(t *testing.T) {
 	t.Parallel()
 
@@ -109,7 +211,7 @@ func TestCompare(t *testing.T) {
 		"major": {
 			safeParse("1.0.0"),
 			args{
-				other: Version{"", 0, 0, 0, 0},
+				other: Version{},
 			},
 			"Major",
 		},
@@ -121,16 +223,23 @@ func TestCompare(t *testing.T) {
 			"Minor",
 		},
 		"patch": {
-			Version{"", 1, 0, 1, 0},
+			Version{major: 1, patch: 1},
 			args{
 				other: safeParse("1.0.0"),
 			},
 			"Patch",
 		},
+		"build": {
+			safeParse("1.0.1.4"),
+			args{
+				other: safeParse("1.0.1.3"),
+			},
+			"Build",
+		},
 		"suffix": {
-			Version{"", 1, 0, 1, alpha},
+			Version{major: 1, patch: 1, suffix: alpha},
 			args{
-				other: Version{"", 1, 0, 1, beta},
+				other: Version{major: 1, patch: 1, suffix: beta},
 			},
 			"Suffix",
 		},
@@ -174,18 +283,25 @@ func TestParse(t *testing.T) {
 			Version{},
 			errors.New("parse version"),
 		},
-		"not a semver too many": {
+		"four components": {
 			args{
 				version: "v2.2.1.0-0.3.rc3",
 			},
-			Version{},
-			errors.New("parse version"),
+			Version{Name: "v2.2.1.0-0.3.rc3", prerelease: []string{"0", "3", "rc3"}, revisions: []uint64{0}, major: 2, minor: 2, patch: 1, suffix: rc},
+			nil,
+		},
+		"arbitrary depth": {
+			args{
+				version: "1.28.4.2.1",
+			},
+			Version{Name: "1.28.4.2.1", revisions: []uint64{2, 1}, major: 1, minor: 28, patch: 4, suffix: -1},
+			nil,
 		},
 		"linkerd": {
 			args{
 				version: "stable-2.14.6",
 			},
-			Version{"stable-2.14.6", 2, 14, 6, -1},
+			Version{Name: "stable-2.14.6", major: 2, minor: 14, patch: 6, suffix: -1},
 			nil,
 		},
 		"linkerd2": {
@@ -200,7 +316,7 @@ func TestParse(t *testing.T) {
 				version: "cassandra-4.1.3",
 				name:    "cassandra",
 			},
-			Version{"cassandra-4.1.3", 4, 1, 3, -1},
+			Version{Name: "cassandra-4.1.3", major: 4, minor: 1, patch: 3, suffix: -1},
 			nil,
 		},
 		"jq": {
@@ -208,70 +324,70 @@ func TestParse(t *testing.T) {
 				version: "jq-1.7",
 				name:    "jq",
 			},
-			Version{"jq-1.7", 1, 7, 0, -1},
+			Version{Name: "jq-1.7", major: 1, minor: 7, suffix: -1},
 			nil,
 		},
 		"flag rc version": {
 			args{
 				version: "v2.27.0-rc1",
 			},
-			Version{"v2.27.0-rc1", 2, 27, 0, rc},
+			Version{Name: "v2.27.0-rc1", prerelease: []string{"rc1"}, major: 2, minor: 27, suffix: rc},
 			nil,
 		},
 		"ignore test": {
 			args{
 				version: "1.26.0-test",
 			},
-			Version{"1.26.0-test", 1, 26, 0, test},
+			Version{Name: "1.26.0-test", prerelease: []string{"test"}, major: 1, minor: 26, suffix: test},
 			nil,
 		},
 		"ignore canary": {
 			args{
 				version: "v10.0.4-canary.1",
 			},
-			Version{"v10.0.4-canary.1", 10, 0, 4, canary},
+			Version{Name: "v10.0.4-canary.1", prerelease: []string{"canary", "1"}, major: 10, patch: 4, suffix: canary},
 			nil,
 		},
 		"ignore alpha": {
 			args{
 				version: "v0.14.0-alpha20200910",
 			},
-			Version{"v0.14.0-alpha20200910", 0, 14, 0, alpha},
+			Version{Name: "v0.14.0-alpha20200910", prerelease: []string{"alpha20200910"}, minor: 14, suffix: alpha},
 			nil,
 		},
 		"major and minor only": {
 			args{
 				version: "v1.25",
 			},
-			Version{"v1.25", 1, 25, 0, -1},
+			Version{Name: "v1.25", major: 1, minor: 25, suffix: -1},
 			nil,
 		},
 		"major and minor only with release": {
 			args{
 				version: "v1.25-xyz",
 			},
-			Version{"v1.25-xyz", 1, 25, 0, 0},
+			Version{Name: "v1.25-xyz", prerelease: []string{"xyz"}, major: 1, minor: 25},
 			nil,
 		},
 		"major and minor only with build": {
 			args{
 				version: "v1.25+xyz",
 			},
-			Version{"v1.25+xyz", 1, 25, 0, 0},
+			Version{Name: "v1.25+xyz", major: 1, minor: 25},
 			nil,
 		},
 		"full": {
 			args{
 				version: "v1.2.3",
 			},
-			Version{"v1.2.3", 1, 2, 3, -1},
+			Version{Name: "v1.2.3", major: 1, minor: 2, patch: 3, suffix: -1},
 			nil,
 		},
 		"with sha": {
 			args{
 				version: "v1.2.3-abcdef123456",
 			},
-			Version{"v1.2.3-abcdef123456", 1, 2, 3, 0},
+			Version{Name: "v1.2.3-abcdef123456", prerelease: []string{"abcdef123456"}, major: 1, minor: 2, patch: 3},
 			nil,
 		},
 		"fucking date": {
@@ -304,7 +420,7 @@ func TestParse(t *testing.T) {
 				failed = true
 			} else if testCase.wantErr != nil && !strings.Contains(gotErr.Error(), testCase.wantErr.Error()) {
 				failed = true
-			} else if got != testCase.want {
+			} else if !reflect.DeepEqual(got, testCase.want) {
 				failed = true
 			}
 
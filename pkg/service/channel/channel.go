@@ -0,0 +1,149 @@
+package channel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"strings"
+
+	httpModel "github.com/ViBiOh/httputils/v4/pkg/model"
+	"github.com/ViBiOh/ketchup/pkg/model"
+)
+
+type Service struct {
+	channelStore model.ChannelStore
+	lookup       func(context.Context, string) ([]net.IPAddr, error)
+}
+
+func New(channelStore model.ChannelStore) Service {
+	return Service{
+		channelStore: channelStore,
+		lookup:       net.DefaultResolver.LookupIPAddr,
+	}
+}
+
+func (s Service) List(ctx context.Context) ([]model.Channel, error) {
+	list, err := s.channelStore.ListByUser(ctx, model.ReadUser(ctx).ID)
+	if err != nil {
+		return nil, httpModel.WrapInternal(fmt.Errorf("list: %w", err))
+	}
+
+	return list, nil
+}
+
+// ListForUser gives the channels of the user, falling back to its email address when none is configured
+func (s Service) ListForUser(ctx context.Context, user model.User) ([]model.Channel, error) {
+	list, err := s.channelStore.ListByUser(ctx, user.ID)
+	if err != nil {
+		return nil, httpModel.WrapInternal(fmt.Errorf("list by user: %w", err))
+	}
+
+	if len(list) == 0 {
+		return []model.Channel{model.NewEmailChannel(user)}, nil
+	}
+
+	for index := range list {
+		list[index].User = user
+	}
+
+	return list, nil
+}
+
+func (s Service) Create(ctx context.Context, item model.Channel) (model.Channel, error) {
+	if err := s.check(ctx, item); err != nil {
+		return model.Channel{}, httpModel.WrapInvalid(err)
+	}
+
+	id, err := s.channelStore.Create(ctx, item)
+	if err != nil {
+		return model.Channel{}, httpModel.WrapInternal(fmt.Errorf("create: %w", err))
+	}
+
+	item.ID = id
+
+	return item, nil
+}
+
+func (s Service) Delete(ctx context.Context, item model.Channel) error {
+	if model.ReadUser(ctx).IsZero() {
+		return httpModel.WrapInvalid(errors.New("you must be logged in for interacting"))
+	}
+
+	if err := s.channelStore.Delete(ctx, item); err != nil {
+		return httpModel.WrapInternal(fmt.Errorf("delete: %w", err))
+	}
+
+	return nil
+}
+
+func (s Service) check(ctx context.Context, item model.Channel) error {
+	var output []error
+
+	if model.ReadUser(ctx).IsZero() {
+		output = append(output, errors.New("you must be logged in for interacting"))
+	}
+
+	if len(item.Frequencies) == 0 {
+		output = append(output, errors.New("at least one frequency is required"))
+	}
+
+	for _, frequency := range item.Frequencies {
+		if frequency == model.None {
+			output = append(output, errors.New("none frequency can't be notified"))
+		}
+	}
+
+	address := strings.TrimSpace(item.URL)
+
+	switch item.Kind {
+	case model.Email:
+		if len(address) != 0 {
+			if _, err := mail.ParseAddress(address); err != nil {
+				output = append(output, fmt.Errorf("email is invalid: %w", err))
+			}
+		}
+	default:
+		if len(address) == 0 {
+			output = append(output, errors.New("url is required"))
+		} else if parsed, err := url.Parse(address); err != nil || parsed.Scheme != "https" || len(parsed.Hostname()) == 0 {
+			output = append(output, errors.New("url must be an absolute https url"))
+		} else if err := s.checkHost(ctx, parsed.Hostname()); err != nil {
+			output = append(output, err)
+		}
+
+		if item.Kind == model.Gotify && len(item.Secret) == 0 {
+			output = append(output, errors.New("application token is required for gotify"))
+		}
+	}
+
+	return httpModel.ConcatError(output)
+}
+
+// checkHost rejects the hosts resolving to an internal address, for the notifier not to be used for reaching the network it runs in
+func (s Service) checkHost(ctx context.Context, host string) error {
+	var ips []net.IP
+
+	if ip := net.ParseIP(host); ip != nil {
+		ips = append(ips, ip)
+	} else {
+		addresses, err := s.lookup(ctx, host)
+		if err != nil {
+			return fmt.Errorf("url host can't be resolved: %w", err)
+		}
+
+		for _, address := range addresses {
+			ips = append(ips, address.IP)
+		}
+	}
+
+	for _, ip := range ips {
+		if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsUnspecified() {
+			return fmt.Errorf("url host `%s` must not be a loopback, link-local or private address", host)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,271 @@
+package channel
+
+import (
+	"context"
+	"errors"
+	"net"
+	"reflect"
+	"testing"
+
+	authModel "github.com/ViBiOh/auth/v3/pkg/model"
+	httpModel "github.com/ViBiOh/httputils/v4/pkg/model"
+	"github.com/ViBiOh/ketchup/pkg/mocks"
+	"github.com/ViBiOh/ketchup/pkg/model"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListForUser(t *testing.T) {
+	t.Parallel()
+
+	user := model.NewUser(1, "nobody@localhost", authModel.NewUser(""))
+
+	type args struct {
+		user model.User
+	}
+
+	cases := map[string]struct {
+		args    args
+		want    []model.Channel
+		wantErr error
+	}{
+		"simple": {
+			args{
+				user: user,
+			},
+			[]model.Channel{
+				{ID: 1, Kind: model.Slack, URL: "https://hooks.slack.com/services/T0/B0/X", User: user, Frequencies: []model.KetchupFrequency{model.Daily}},
+			},
+			nil,
+		},
+		"default": {
+			args{
+				user: user,
+			},
+			[]model.Channel{model.NewEmailChannel(user)},
+			nil,
+		},
+		"error": {
+			args{
+				user: user,
+			},
+			nil,
+			httpModel.ErrInternalError,
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			mockChannelStore := mocks.NewChannelStore(ctrl)
+
+			instance := Service{
+				channelStore: mockChannelStore,
+			}
+
+			switch intention {
+			case "simple":
+				mockChannelStore.EXPECT().ListByUser(gomock.Any(), model.Identifier(1)).Return([]model.Channel{
+					{ID: 1, Kind: model.Slack, URL: "https://hooks.slack.com/services/T0/B0/X", User: model.User{ID: 1}, Frequencies: []model.KetchupFrequency{model.Daily}},
+				}, nil)
+			case "default":
+				mockChannelStore.EXPECT().ListByUser(gomock.Any(), model.Identifier(1)).Return(nil, nil)
+			case "error":
+				mockChannelStore.EXPECT().ListByUser(gomock.Any(), model.Identifier(1)).Return(nil, errors.New("failed"))
+			}
+
+			got, gotErr := instance.ListForUser(context.TODO(), testCase.args.user)
+
+			failed := false
+
+			if !errors.Is(gotErr, testCase.wantErr) {
+				failed = true
+			} else if !reflect.DeepEqual(got, testCase.want) {
+				failed = true
+			}
+
+			if failed {
+				t.Errorf("ListForUser() = (%+v, `%s`), want (%+v, `%s`)", got, gotErr, testCase.want, testCase.wantErr)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	t.Parallel()
+
+	lookup := func(_ context.Context, host string) ([]net.IPAddr, error) {
+		switch host {
+		case "localhost":
+			return []net.IPAddr{{IP: net.ParseIP("127.0.0.1")}, {IP: net.ParseIP("::1")}}, nil
+		case "hooks.example.com", "gotify.example.com":
+			return []net.IPAddr{{IP: net.ParseIP("203.0.113.10")}}, nil
+		default:
+			return nil, errors.New("no such host")
+		}
+	}
+
+	ctx := model.StoreUser(context.TODO(), model.NewUser(1, "nobody@localhost", authModel.NewUser("")))
+
+	type args struct {
+		ctx  context.Context
+		item model.Channel
+	}
+
+	cases := map[string]struct {
+		args    args
+		want    model.Channel
+		wantErr error
+	}{
+		"no user": {
+			args{
+				ctx:  context.TODO(),
+				item: model.Channel{Kind: model.Email, Frequencies: []model.KetchupFrequency{model.Daily}},
+			},
+			model.Channel{},
+			httpModel.ErrInvalid,
+		},
+		"no frequency": {
+			args{
+				ctx:  ctx,
+				item: model.Channel{Kind: model.Email},
+			},
+			model.Channel{},
+			httpModel.ErrInvalid,
+		},
+		"none frequency": {
+			args{
+				ctx:  ctx,
+				item: model.Channel{Kind: model.Email, Frequencies: []model.KetchupFrequency{model.None}},
+			},
+			model.Channel{},
+			httpModel.ErrInvalid,
+		},
+		"invalid email": {
+			args{
+				ctx:  ctx,
+				item: model.Channel{Kind: model.Email, URL: "nobody", Frequencies: []model.KetchupFrequency{model.Daily}},
+			},
+			model.Channel{},
+			httpModel.ErrInvalid,
+		},
+		"missing url": {
+			args{
+				ctx:  ctx,
+				item: model.Channel{Kind: model.Webhook, Frequencies: []model.KetchupFrequency{model.Daily}},
+			},
+			model.Channel{},
+			httpModel.ErrInvalid,
+		},
+		"relative url": {
+			args{
+				ctx:  ctx,
+				item: model.Channel{Kind: model.Discord, URL: "/api/webhooks", Frequencies: []model.KetchupFrequency{model.Daily}},
+			},
+			model.Channel{},
+			httpModel.ErrInvalid,
+		},
+		"http url": {
+			args{
+				ctx:  ctx,
+				item: model.Channel{Kind: model.Webhook, URL: "http://hooks.example.com/hook", Frequencies: []model.KetchupFrequency{model.Daily}},
+			},
+			model.Channel{},
+			httpModel.ErrInvalid,
+		},
+		"loopback": {
+			args{
+				ctx:  ctx,
+				item: model.Channel{Kind: model.Webhook, URL: "https://localhost/hook", Frequencies: []model.KetchupFrequency{model.Daily}},
+			},
+			model.Channel{},
+			httpModel.ErrInvalid,
+		},
+		"private address": {
+			args{
+				ctx:  ctx,
+				item: model.Channel{Kind: model.Slack, URL: "https://10.0.0.1/services", Frequencies: []model.KetchupFrequency{model.Daily}},
+			},
+			model.Channel{},
+			httpModel.ErrInvalid,
+		},
+		"link-local address": {
+			args{
+				ctx:  ctx,
+				item: model.Channel{Kind: model.Webhook, URL: "https://169.254.169.254/latest/meta-data", Frequencies: []model.KetchupFrequency{model.Daily}},
+			},
+			model.Channel{},
+			httpModel.ErrInvalid,
+		},
+		"unresolved host": {
+			args{
+				ctx:  ctx,
+				item: model.Channel{Kind: model.Discord, URL: "https://unknown.example.com/api/webhooks", Frequencies: []model.KetchupFrequency{model.Daily}},
+			},
+			model.Channel{},
+			httpModel.ErrInvalid,
+		},
+		"gotify without token": {
+			args{
+				ctx:  ctx,
+				item: model.Channel{Kind: model.Gotify, URL: "https://gotify.example.com", Frequencies: []model.KetchupFrequency{model.Daily}},
+			},
+			model.Channel{},
+			httpModel.ErrInvalid,
+		},
+		"store error": {
+			args{
+				ctx:  ctx,
+				item: model.Channel{Kind: model.Email, Frequencies: []model.KetchupFrequency{model.Weekly}},
+			},
+			model.Channel{},
+			httpModel.ErrInternalError,
+		},
+		"success": {
+			args{
+				ctx:  ctx,
+				item: model.Channel{Kind: model.Webhook, URL: "https://hooks.example.com/hook", Frequencies: []model.KetchupFrequency{model.Daily, model.Weekly}},
+			},
+			model.Channel{ID: 1, Kind: model.Webhook, URL: "https://hooks.example.com/hook", Frequencies: []model.KetchupFrequency{model.Daily, model.Weekly}},
+			nil,
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			mockChannelStore := mocks.NewChannelStore(ctrl)
+
+			instance := Service{
+				channelStore: mockChannelStore,
+				lookup:       lookup,
+			}
+
+			switch intention {
+			case "store error":
+				mockChannelStore.EXPECT().Create(gomock.Any(), gomock.Any()).Return(model.Identifier(0), errors.New("failed"))
+			case "success":
+				mockChannelStore.EXPECT().Create(gomock.Any(), gomock.Any()).Return(model.Identifier(1), nil)
+			}
+
+			got, gotErr := instance.Create(testCase.args.ctx, testCase.args.item)
+
+			failed := false
+
+			if !errors.Is(gotErr, testCase.wantErr) {
+				failed = true
+			} else if !reflect.DeepEqual(got, testCase.want) {
+				failed = true
+			}
+
+			if failed {
+				t.Errorf("Create() = (%+v, `%s`), want (%+v, `%s`)", got, gotErr, testCase.want, testCase.wantErr)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	httpModel "github.com/ViBiOh/httputils/v4/pkg/model"
 	"github.com/ViBiOh/ketchup/pkg/model"
@@ -64,10 +65,23 @@ func (s Service) ListOutdated(ctx context.Context, users ...model.User) ([]model
 	return list, nil
 }
 
+func (s Service) ListMatured(ctx context.Context, now time.Time, frequencies ...model.KetchupFrequency) ([]model.Ketchup, error) {
+	list, err := s.ketchupStore.ListMatured(ctx, now, frequencies...)
+	if err != nil {
+		return nil, httpModel.WrapInternal(fmt.Errorf("list matured: %w", err))
+	}
+
+	return list, nil
+}
+
 func (s Service) Create(ctx context.Context, item model.Ketchup) (model.Ketchup, error) {
 	var output model.Ketchup
 
 	err := s.ketchupStore.DoAtomic(ctx, func(ctx context.Context) error {
+		if err := checkPattern(item.Repository.Kind, item.Pattern); err != nil {
+			return httpModel.WrapInvalid(err)
+		}
+
 		repo, err := s.repository.GetOrCreate(ctx, item.Repository.Kind, item.Repository.Name, item.Repository.Part, item.Pattern)
 		if err != nil {
 			return err
@@ -118,6 +132,8 @@ func (s Service) Update(ctx context.Context, oldPattern string, item model.Ketch
 			Pattern:          item.Pattern,
 			Version:          item.Version,
 			Frequency:        item.Frequency,
+			Severity:         item.Severity,
+			Cooldown:         item.Cooldown,
 			UpdateWhenNotify: item.UpdateWhenNotify,
 			Repository:       old.Repository,
 			User:             old.User,
@@ -161,6 +177,66 @@ func (s Service) UpdateVersion(ctx context.Context, userID, repositoryID model.I
 	})
 }
 
+func (s Service) UpdateNotifiedVersion(ctx context.Context, item model.Ketchup, version string) error {
+	if err := s.ketchupStore.UpdateNotifiedVersion(ctx, item, version); err != nil {
+		return httpModel.WrapInternal(fmt.Errorf("update notified version: %w", err))
+	}
+
+	return nil
+}
+
+func (s Service) UpdateYankNotifiedVersion(ctx context.Context, item model.Ketchup, version string) error {
+	if err := s.ketchupStore.UpdateYankNotifiedVersion(ctx, item, version); err != nil {
+		return httpModel.WrapInternal(fmt.Errorf("update yank notified version: %w", err))
+	}
+
+	return nil
+}
+
+func (s Service) UpdateRemindedAt(ctx context.Context, item model.Ketchup, remindedAt time.Time) error {
+	if err := s.ketchupStore.UpdateRemindedAt(ctx, item, remindedAt); err != nil {
+		return httpModel.WrapInternal(fmt.Errorf("update reminded at: %w", err))
+	}
+
+	return nil
+}
+
+func (s Service) Snooze(ctx context.Context, userID, repositoryID model.Identifier, pattern string, until time.Time) error {
+	if len(pattern) == 0 {
+		return httpModel.WrapInvalid(errors.New("pattern is required"))
+	}
+
+	if err := s.ketchupStore.Snooze(ctx, userID, repositoryID, pattern, until); err != nil {
+		return httpModel.WrapInternal(fmt.Errorf("snooze: %w", err))
+	}
+
+	return nil
+}
+
+func (s Service) SkipVersion(ctx context.Context, userID, repositoryID model.Identifier, pattern, version string) error {
+	if len(pattern) == 0 {
+		return httpModel.WrapInvalid(errors.New("pattern is required"))
+	}
+
+	if err := s.ketchupStore.SkipVersion(ctx, userID, repositoryID, pattern, version); err != nil {
+		return httpModel.WrapInternal(fmt.Errorf("skip version: %w", err))
+	}
+
+	return nil
+}
+
+func (s Service) UpdateFrequency(ctx context.Context, userID, repositoryID model.Identifier, pattern string, frequency model.KetchupFrequency) error {
+	if len(pattern) == 0 {
+		return httpModel.WrapInvalid(errors.New("pattern is required"))
+	}
+
+	if err := s.ketchupStore.UpdateFrequency(ctx, userID, repositoryID, pattern, frequency); err != nil {
+		return httpModel.WrapInternal(fmt.Errorf("update frequency: %w", err))
+	}
+
+	return nil
+}
+
 func (s Service) Delete(ctx context.Context, item model.Ketchup) (err error) {
 	return s.ketchupStore.DoAtomic(ctx, func(ctx context.Context) error {
 		old, err := s.ketchupStore.GetByRepository(ctx, item.Repository.ID, item.Pattern, true)
@@ -197,14 +273,18 @@ func (s Service) check(ctx context.Context, old, new model.Ketchup) error {
 
 	if len(strings.TrimSpace(new.Pattern)) == 0 {
 		output = append(output, errors.New("pattern is required"))
-	} else if _, err := semver.ParsePattern(new.Pattern); err != nil {
-		output = append(output, fmt.Errorf("pattern is invalid: %w", err))
+	} else if err := checkPattern(new.Repository.Kind, new.Pattern); err != nil {
+		output = append(output, err)
 	}
 
 	if len(strings.TrimSpace(new.Version)) == 0 {
 		output = append(output, errors.New("version is required"))
 	}
 
+	if new.Cooldown > model.MaxCooldown {
+		output = append(output, fmt.Errorf("cooldown can't exceed %d days", model.MaxCooldown))
+	}
+
 	if old.Repository.IsZero() && !new.Repository.IsZero() {
 		o, err := s.ketchupStore.GetByRepository(ctx, new.Repository.ID, new.Pattern, false)
 		if err != nil {
@@ -217,6 +297,20 @@ func (s Service) check(ctx context.Context, old, new model.Ketchup) error {
 	return httpModel.ConcatError(output)
 }
 
+// checkPattern validates the pattern for the kind of repository, before the provider resolves it
+func checkPattern(kind model.RepositoryKind, rawPattern string) error {
+	pattern, err := semver.ParsePattern(rawPattern)
+	if err != nil {
+		return fmt.Errorf("pattern is invalid: %w", err)
+	}
+
+	if _, ok := pattern.DistTag(); ok && kind != model.NPM {
+		return errors.New("dist-tag pattern is only available for npm")
+	}
+
+	return nil
+}
+
 func enrichKetchupsWithSemver(list []model.Ketchup) []model.Ketchup {
 	output := make([]model.Ketchup, len(list))
 
@@ -228,14 +322,12 @@ func enrichKetchupsWithSemver(list []model.Ketchup) []model.Ketchup {
 }
 
 func enrichKetchupWithSemver(item model.Ketchup) model.Ketchup {
-	name := semver.ExtractName(item.Repository.Name)
-
-	repositoryVersion, err := semver.Parse(item.Repository.Versions[item.Pattern], name)
+	repositoryVersion, err := item.Repository.ParseVersion(item.Repository.Versions[item.Pattern])
 	if err != nil {
 		return item
 	}
 
-	ketchupVersion, err := semver.Parse(item.Version, name)
+	ketchupVersion, err := item.Repository.ParseVersion(item.Version)
 	if err != nil {
 		return item
 	}
@@ -180,10 +180,18 @@ func TestCreate(t *testing.T) {
 			model.Ketchup{},
 			errAtomicStart,
 		},
+		"dist-tag on github": {
+			args{
+				ctx:  model.StoreUser(context.TODO(), model.NewUser(1, "", authModel.NewUser(""))),
+				item: model.NewKetchup("dist-tag:next", "1.0.0", model.Daily, false, model.NewGithubRepository(model.Identifier(1), ketchupRepository)),
+			},
+			model.Ketchup{},
+			httpModel.ErrInvalid,
+		},
 		"repository error": {
 			args{
 				ctx:  context.TODO(),
-				item: model.Ketchup{},
+				item: model.Ketchup{Pattern: model.DefaultPattern},
 			},
 			model.Ketchup{},
 			httpModel.ErrInvalid,
@@ -231,6 +239,11 @@ func TestCreate(t *testing.T) {
 			switch intention {
 			case "start atomic error":
 				mockKetchupStore.EXPECT().DoAtomic(gomock.Any(), gomock.Any()).Return(errAtomicStart)
+			case "dist-tag on github":
+				dummyFn := func(ctx context.Context, do func(ctx context.Context) error) error {
+					return do(ctx)
+				}
+				mockKetchupStore.EXPECT().DoAtomic(gomock.Any(), gomock.Any()).DoAndReturn(dummyFn)
 			case "repository error", "check error":
 				dummyFn := func(ctx context.Context, do func(ctx context.Context) error) error {
 					return do(ctx)
@@ -602,6 +615,14 @@ func TestCheck(t *testing.T) {
 			},
 			errors.New("pattern is invalid"),
 		},
+		"dist-tag pattern": {
+			args{
+				ctx: model.StoreUser(context.TODO(), model.NewUser(1, "", authModel.NewUser(""))),
+				old: model.Ketchup{},
+				new: model.NewKetchup("dist-tag:next", "1.0.0", model.Daily, false, model.NewGithubRepository(model.Identifier(1), "")),
+			},
+			errors.New("dist-tag pattern is only available for npm"),
+		},
 		"no version": {
 			args{
 				ctx: model.StoreUser(context.TODO(), model.NewUser(1, "", authModel.NewUser(""))),
@@ -639,7 +660,7 @@ func TestCheck(t *testing.T) {
 			}
 
 			switch intention {
-			case "no pattern", "invalid pattern", "no version":
+			case "no pattern", "invalid pattern", "dist-tag pattern", "no version":
 				mockKetchupStore.EXPECT().GetByRepository(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(model.Ketchup{}, nil)
 			case "create error":
 				mockKetchupStore.EXPECT().GetByRepository(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(model.Ketchup{}, errors.New("failed"))
@@ -0,0 +1,68 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	httpModel "github.com/ViBiOh/httputils/v4/pkg/model"
+	"github.com/ViBiOh/ketchup/pkg/model"
+)
+
+type Service struct {
+	notificationStore model.NotificationStore
+}
+
+func New(notificationStore model.NotificationStore) Service {
+	return Service{
+		notificationStore: notificationStore,
+	}
+}
+
+func (s Service) DoAtomic(ctx context.Context, action func(context.Context) error) error {
+	return s.notificationStore.DoAtomic(ctx, action)
+}
+
+// Queue adds the releases to the digest already pending for the same channel and time, or creates it
+func (s Service) Queue(ctx context.Context, item model.Notification) (model.Notification, error) {
+	pending, err := s.notificationStore.GetPending(ctx, item)
+	if err != nil {
+		return model.Notification{}, httpModel.WrapInternal(fmt.Errorf("get pending: %w", err))
+	}
+
+	if !pending.ID.IsZero() {
+		pending = pending.Merge(item.Releases)
+
+		if err := s.notificationStore.UpdateReleases(ctx, pending); err != nil {
+			return model.Notification{}, httpModel.WrapInternal(fmt.Errorf("update releases: %w", err))
+		}
+
+		return pending, nil
+	}
+
+	id, err := s.notificationStore.Create(ctx, item)
+	if err != nil {
+		return model.Notification{}, httpModel.WrapInternal(fmt.Errorf("create: %w", err))
+	}
+
+	item.ID = id
+
+	return item, nil
+}
+
+func (s Service) ListDue(ctx context.Context, now time.Time) ([]model.Notification, error) {
+	list, err := s.notificationStore.ListDue(ctx, now, model.MaxNotificationAttempts)
+	if err != nil {
+		return nil, httpModel.WrapInternal(fmt.Errorf("list due: %w", err))
+	}
+
+	return list, nil
+}
+
+func (s Service) Update(ctx context.Context, item model.Notification) error {
+	if err := s.notificationStore.Update(ctx, item); err != nil {
+		return httpModel.WrapInternal(fmt.Errorf("update: %w", err))
+	}
+
+	return nil
+}
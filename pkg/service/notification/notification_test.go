@@ -0,0 +1,89 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	httpModel "github.com/ViBiOh/httputils/v4/pkg/model"
+	"github.com/ViBiOh/ketchup/pkg/mocks"
+	"github.com/ViBiOh/ketchup/pkg/model"
+	"go.uber.org/mock/gomock"
+)
+
+func TestQueue(t *testing.T) {
+	t.Parallel()
+
+	scheduledAt := time.Date(2021, 1, 1, 8, 0, 0, 0, time.UTC)
+	first := model.Release{Repository: model.Repository{ID: 1}, Pattern: "stable", Current: "1.0.0"}
+	second := model.Release{Repository: model.Repository{ID: 2}, Pattern: "stable"}
+
+	notification := model.NewNotification(model.NewEmailChannel(model.User{ID: 1}), []model.Release{first}, scheduledAt)
+	pending := model.Notification{ID: 2, Channel: notification.Channel, ScheduledAt: scheduledAt, Status: model.Pending, Releases: []model.Release{{Repository: first.Repository, Pattern: "stable"}, second}}
+
+	cases := map[string]struct {
+		want    model.Notification
+		wantErr error
+	}{
+		"error": {
+			model.Notification{},
+			httpModel.ErrInternalError,
+		},
+		"pending error": {
+			model.Notification{},
+			httpModel.ErrInternalError,
+		},
+		"success": {
+			model.Notification{ID: 1, Channel: notification.Channel, ScheduledAt: scheduledAt, Status: model.Pending, Releases: []model.Release{first}},
+			nil,
+		},
+		"merge": {
+			model.Notification{ID: 2, Channel: notification.Channel, ScheduledAt: scheduledAt, Status: model.Pending, Releases: []model.Release{second, first}},
+			nil,
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			mockNotificationStore := mocks.NewNotificationStore(ctrl)
+
+			instance := Service{
+				notificationStore: mockNotificationStore,
+			}
+
+			switch intention {
+			case "error":
+				mockNotificationStore.EXPECT().GetPending(gomock.Any(), notification).Return(model.Notification{}, nil)
+				mockNotificationStore.EXPECT().Create(gomock.Any(), notification).Return(model.Identifier(0), errors.New("failed"))
+			case "pending error":
+				mockNotificationStore.EXPECT().GetPending(gomock.Any(), notification).Return(model.Notification{}, errors.New("failed"))
+			case "success":
+				mockNotificationStore.EXPECT().GetPending(gomock.Any(), notification).Return(model.Notification{}, nil)
+				mockNotificationStore.EXPECT().Create(gomock.Any(), notification).Return(model.Identifier(1), nil)
+			case "merge":
+				mockNotificationStore.EXPECT().GetPending(gomock.Any(), notification).Return(pending, nil)
+				mockNotificationStore.EXPECT().UpdateReleases(gomock.Any(), testCase.want).Return(nil)
+			}
+
+			got, gotErr := instance.Queue(context.TODO(), notification)
+
+			failed := false
+
+			if !errors.Is(gotErr, testCase.wantErr) {
+				failed = true
+			} else if !reflect.DeepEqual(got, testCase.want) {
+				failed = true
+			}
+
+			if failed {
+				t.Errorf("Queue() = (%+v, `%s`), want (%+v, `%s`)", got, gotErr, testCase.want, testCase.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package release
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	httpModel "github.com/ViBiOh/httputils/v4/pkg/model"
+	"github.com/ViBiOh/ketchup/pkg/model"
+)
+
+type Service struct {
+	releaseStore model.ReleaseStore
+}
+
+func New(releaseStore model.ReleaseStore) Service {
+	return Service{
+		releaseStore: releaseStore,
+	}
+}
+
+func (s Service) DoAtomic(ctx context.Context, action func(context.Context) error) error {
+	return s.releaseStore.DoAtomic(ctx, action)
+}
+
+// Record keeps track of the detected releases, yanked versions being withdrawn rather than released
+func (s Service) Record(ctx context.Context, releases []model.Release) error {
+	for _, release := range releases {
+		if release.Version.IsYanked() {
+			if err := s.releaseStore.Yank(ctx, release); err != nil {
+				return httpModel.WrapInternal(fmt.Errorf("yank release `%s` of `%s`: %w", release.Version.Name, release.Repository.Name, err))
+			}
+
+			continue
+		}
+
+		if err := s.releaseStore.Create(ctx, release); err != nil {
+			return httpModel.WrapInternal(fmt.Errorf("create release `%s` of `%s`: %w", release.Version.Name, release.Repository.Name, err))
+		}
+	}
+
+	return nil
+}
+
+func (s Service) ListForUser(ctx context.Context, user model.User, count uint) ([]model.Release, error) {
+	list, err := s.releaseStore.ListByUser(ctx, user.ID, count)
+	if err != nil {
+		return nil, httpModel.WrapInternal(fmt.Errorf("list by user: %w", err))
+	}
+
+	return list, nil
+}
+
+// ListPending gives the releases recorded since the last notification, with their version parsed for comparison
+func (s Service) ListPending(ctx context.Context) ([]model.Release, error) {
+	list, err := s.releaseStore.ListPending(ctx)
+	if err != nil {
+		return nil, httpModel.WrapInternal(fmt.Errorf("list pending: %w", err))
+	}
+
+	return parseVersions(ctx, list, false), nil
+}
+
+func (s Service) ListYanked(ctx context.Context) ([]model.Release, error) {
+	list, err := s.releaseStore.ListYanked(ctx)
+	if err != nil {
+		return nil, httpModel.WrapInternal(fmt.Errorf("list yanked: %w", err))
+	}
+
+	return parseVersions(ctx, list, true), nil
+}
+
+func (s Service) MarkNotified(ctx context.Context, releases []model.Release) error {
+	for _, release := range releases {
+		if err := s.releaseStore.MarkNotified(ctx, release); err != nil {
+			return httpModel.WrapInternal(fmt.Errorf("mark release `%s` of `%s` as notified: %w", release.Version.Name, release.Repository.Name, err))
+		}
+	}
+
+	return nil
+}
+
+func parseVersions(ctx context.Context, releases []model.Release, yanked bool) []model.Release {
+	output := releases[:0]
+
+	for _, release := range releases {
+		version, err := release.Repository.ParseVersion(release.Version.Name)
+		if err != nil {
+			slog.LogAttrs(ctx, slog.LevelError, "parse recorded version", slog.String("repo", release.Repository.String()), slog.String("version", release.Version.Name), slog.Any("error", err))
+			continue
+		}
+
+		if yanked {
+			version = version.Yank()
+		}
+
+		release.Version = version
+		output = append(output, release)
+	}
+
+	return output
+}
@@ -0,0 +1,154 @@
+package release
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	httpModel "github.com/ViBiOh/httputils/v4/pkg/model"
+	"github.com/ViBiOh/ketchup/pkg/mocks"
+	"github.com/ViBiOh/ketchup/pkg/model"
+	"github.com/ViBiOh/ketchup/pkg/semver"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRecord(t *testing.T) {
+	t.Parallel()
+
+	repository := model.NewGithubRepository(model.Identifier(1), "vibioh/ketchup")
+
+	type args struct {
+		releases []model.Release
+	}
+
+	cases := map[string]struct {
+		args    args
+		wantErr error
+	}{
+		"empty": {
+			args{},
+			nil,
+		},
+		"yanked": {
+			args{
+				releases: []model.Release{
+					model.NewRelease(repository, model.DefaultPattern, semver.Version{Name: "1.0.0"}.Yank()),
+				},
+			},
+			nil,
+		},
+		"error": {
+			args{
+				releases: []model.Release{
+					model.NewRelease(repository, model.DefaultPattern, semver.Version{Name: "1.0.0"}),
+				},
+			},
+			httpModel.ErrInternalError,
+		},
+		"success": {
+			args{
+				releases: []model.Release{
+					model.NewRelease(repository, model.DefaultPattern, semver.Version{Name: "1.0.0"}),
+					model.NewRelease(repository, model.DefaultPattern, semver.Version{Name: "0.9.0"}.Yank()),
+				},
+			},
+			nil,
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			mockReleaseStore := mocks.NewReleaseStore(ctrl)
+
+			instance := Service{
+				releaseStore: mockReleaseStore,
+			}
+
+			switch intention {
+			case "yanked":
+				mockReleaseStore.EXPECT().Yank(gomock.Any(), testCase.args.releases[0]).Return(nil)
+			case "error":
+				mockReleaseStore.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("failed"))
+			case "success":
+				mockReleaseStore.EXPECT().Create(gomock.Any(), testCase.args.releases[0]).Return(nil)
+				mockReleaseStore.EXPECT().Yank(gomock.Any(), testCase.args.releases[1]).Return(nil)
+			}
+
+			gotErr := instance.Record(context.TODO(), testCase.args.releases)
+
+			if !errors.Is(gotErr, testCase.wantErr) {
+				t.Errorf("Record() = `%s`, want `%s`", gotErr, testCase.wantErr)
+			}
+		})
+	}
+}
+
+func TestListPending(t *testing.T) {
+	t.Parallel()
+
+	repository := model.NewGithubRepository(model.Identifier(1), "vibioh/ketchup")
+
+	version, err := repository.ParseVersion("1.1.0")
+	if err != nil {
+		t.Fatalf("parse version: %s", err)
+	}
+
+	cases := map[string]struct {
+		want    []model.Release
+		wantErr error
+	}{
+		"error": {
+			nil,
+			httpModel.ErrInternalError,
+		},
+		"success": {
+			[]model.Release{
+				model.NewRelease(repository, model.DefaultPattern, version),
+			},
+			nil,
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			mockReleaseStore := mocks.NewReleaseStore(ctrl)
+
+			instance := Service{
+				releaseStore: mockReleaseStore,
+			}
+
+			switch intention {
+			case "error":
+				mockReleaseStore.EXPECT().ListPending(gomock.Any()).Return(nil, errors.New("failed"))
+			case "success":
+				mockReleaseStore.EXPECT().ListPending(gomock.Any()).Return([]model.Release{
+					model.NewRelease(repository, model.DefaultPattern, semver.Version{Name: "1.1.0"}),
+					model.NewRelease(repository, "^2.0", semver.Version{Name: "not a version"}),
+				}, nil)
+			}
+
+			got, gotErr := instance.ListPending(context.TODO())
+
+			failed := false
+
+			if !errors.Is(gotErr, testCase.wantErr) {
+				failed = true
+			} else if !reflect.DeepEqual(got, testCase.want) {
+				failed = true
+			}
+
+			if failed {
+				t.Errorf("ListPending() = (%+v, `%s`), want (%+v, `%s`)", got, gotErr, testCase.want, testCase.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package report
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	httpModel "github.com/ViBiOh/httputils/v4/pkg/model"
+	"github.com/ViBiOh/ketchup/pkg/model"
+)
+
+// retention is how long reports are kept, enough to compare the runs of a month
+const retention = 30 * 24 * time.Hour
+
+type Service struct {
+	reportStore model.ReportStore
+}
+
+func New(reportStore model.ReportStore) Service {
+	return Service{
+		reportStore: reportStore,
+	}
+}
+
+func (s Service) Save(ctx context.Context, item model.Report) error {
+	if err := s.reportStore.Create(ctx, item); err != nil {
+		return httpModel.WrapInternal(fmt.Errorf("create: %w", err))
+	}
+
+	if err := s.reportStore.DeleteBefore(ctx, item.StartedAt.Add(-retention)); err != nil {
+		return httpModel.WrapInternal(fmt.Errorf("delete old reports: %w", err))
+	}
+
+	return nil
+}
+
+func (s Service) ListLatest(ctx context.Context) ([]model.Report, error) {
+	list, err := s.reportStore.ListLatest(ctx)
+	if err != nil {
+		return nil, httpModel.WrapInternal(fmt.Errorf("list latest: %w", err))
+	}
+
+	return list, nil
+}
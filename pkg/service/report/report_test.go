@@ -0,0 +1,63 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	httpModel "github.com/ViBiOh/httputils/v4/pkg/model"
+	"github.com/ViBiOh/ketchup/pkg/mocks"
+	"github.com/ViBiOh/ketchup/pkg/model"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSave(t *testing.T) {
+	t.Parallel()
+
+	startedAt := time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)
+	report := model.NewReport(model.NotifierReport, startedAt)
+
+	cases := map[string]struct {
+		wantErr error
+	}{
+		"success": {
+			nil,
+		},
+		"create error": {
+			httpModel.ErrInternalError,
+		},
+		"delete error": {
+			httpModel.ErrInternalError,
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			mockReportStore := mocks.NewReportStore(ctrl)
+
+			instance := Service{
+				reportStore: mockReportStore,
+			}
+
+			switch intention {
+			case "success":
+				mockReportStore.EXPECT().Create(gomock.Any(), report).Return(nil)
+				mockReportStore.EXPECT().DeleteBefore(gomock.Any(), time.Date(2026, 9, 19, 8, 0, 0, 0, time.UTC)).Return(nil)
+			case "create error":
+				mockReportStore.EXPECT().Create(gomock.Any(), report).Return(errors.New("failed"))
+			case "delete error":
+				mockReportStore.EXPECT().Create(gomock.Any(), report).Return(nil)
+				mockReportStore.EXPECT().DeleteBefore(gomock.Any(), gomock.Any()).Return(errors.New("failed"))
+			}
+
+			if gotErr := instance.Save(context.TODO(), report); !errors.Is(gotErr, testCase.wantErr) {
+				t.Errorf("Save() = `%s`, want `%s`", gotErr, testCase.wantErr)
+			}
+		})
+	}
+}
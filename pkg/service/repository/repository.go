@@ -16,14 +16,14 @@ var nameMatcher = regexp.MustCompile(`(?i)(?:github\.com/)?([^/\n]+/[^/\n]+)`)
 
 type Service struct {
 	repository model.RepositoryStore
-	github     model.GenericProvider
+	github     model.DetailProvider
 	helm       model.HelmProvider
 	docker     model.GenericProvider
-	npm        model.GenericProvider
-	pypi       model.GenericProvider
+	npm        model.YankProvider
+	pypi       model.YankProvider
 }
 
-func New(repositoryStore model.RepositoryStore, githubService model.GenericProvider, helmService model.HelmProvider, dockerService, npmService, pypiService model.GenericProvider) Service {
+func New(repositoryStore model.RepositoryStore, githubService model.DetailProvider, helmService model.HelmProvider, dockerService model.GenericProvider, npmService, pypiService model.YankProvider) Service {
 	return Service{
 		repository: repositoryStore,
 		github:     githubService,
@@ -34,8 +34,8 @@ func New(repositoryStore model.RepositoryStore, githubService model.GenericProvi
 	}
 }
 
-func (s Service) List(ctx context.Context, pageSize uint, last model.Identifier) ([]model.Repository, error) {
-	list, err := s.repository.List(ctx, pageSize, last)
+func (s Service) List(ctx context.Context, pageSize uint, last model.Identifier, kinds ...model.RepositoryKind) ([]model.Repository, error) {
+	list, err := s.repository.List(ctx, pageSize, last, kinds...)
 	if err != nil {
 		return nil, httpModel.WrapInternal(fmt.Errorf("list: %w", err))
 	}
@@ -187,15 +187,9 @@ func (s Service) check(ctx context.Context, old, new model.Repository) error {
 }
 
 func (s Service) LatestVersions(ctx context.Context, repo model.Repository) (map[string]semver.Version, error) {
-	if len(repo.Versions) == 0 {
-		return nil, errors.New("no pattern for fetching latest versions")
-	}
-
-	index := 0
-	patterns := make([]string, len(repo.Versions))
-	for pattern := range repo.Versions {
-		patterns[index] = pattern
-		index++
+	patterns, err := repositoryPatterns(repo)
+	if err != nil {
+		return nil, err
 	}
 
 	switch repo.Kind {
@@ -214,6 +208,52 @@ func (s Service) LatestVersions(ctx context.Context, repo model.Repository) (map
 	}
 }
 
+// Versions returns the latest versions and, for the registries that withdraw versions, the yanked ones from the same request
+func (s Service) Versions(ctx context.Context, repo model.Repository) (map[string]semver.Version, []string, error) {
+	var provider model.YankProvider
+
+	switch repo.Kind {
+	case model.NPM:
+		provider = s.npm
+	case model.Pypi:
+		provider = s.pypi
+	default:
+		versions, err := s.LatestVersions(ctx, repo)
+		return versions, nil, err
+	}
+
+	patterns, err := repositoryPatterns(repo)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return provider.Versions(ctx, repo.Name, patterns)
+}
+
+func (s Service) ReleaseDetail(ctx context.Context, repo model.Repository, version string) (model.ReleaseDetail, error) {
+	switch repo.Kind {
+	case model.Github:
+		return s.github.ReleaseDetail(ctx, repo.Name, version)
+	default:
+		return model.ReleaseDetail{}, nil
+	}
+}
+
+func repositoryPatterns(repo model.Repository) ([]string, error) {
+	if len(repo.Versions) == 0 {
+		return nil, errors.New("no pattern for fetching latest versions")
+	}
+
+	index := 0
+	patterns := make([]string, len(repo.Versions))
+	for pattern := range repo.Versions {
+		patterns[index] = pattern
+		index++
+	}
+
+	return patterns, nil
+}
+
 func sanitizeName(name string) string {
 	matches := nameMatcher.FindStringSubmatch(name)
 	if len(matches) > 0 {
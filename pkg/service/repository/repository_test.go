@@ -264,7 +264,7 @@ func TestGetOrCreate(t *testing.T) {
 			ctrl := gomock.NewController(t)
 
 			mockRepositoryStore := mocks.NewRepositoryStore(ctrl)
-			mockGithub := mocks.NewGenericProvider(ctrl)
+			mockGithub := mocks.NewDetailProvider(ctrl)
 
 			instance := Service{
 				repository: mockRepositoryStore,
@@ -380,7 +380,7 @@ func TestCreate(t *testing.T) {
 			ctrl := gomock.NewController(t)
 
 			mockRepositoryStore := mocks.NewRepositoryStore(ctrl)
-			mockGithub := mocks.NewGenericProvider(ctrl)
+			mockGithub := mocks.NewDetailProvider(ctrl)
 
 			instance := Service{
 				repository: mockRepositoryStore,
@@ -2,16 +2,21 @@ package user
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"log/slog"
 	"strings"
+	"time"
 
 	authModel "github.com/ViBiOh/auth/v3/pkg/model"
 	httpModel "github.com/ViBiOh/httputils/v4/pkg/model"
 	"github.com/ViBiOh/ketchup/pkg/model"
 )
 
+const feedTokenLength = 32
+
 type Service struct {
 	store model.UserStore
 	auth  model.AuthService
@@ -93,6 +98,95 @@ func (s Service) check(ctx context.Context, email, login, password string) error
 	return httpModel.ConcatError(output)
 }
 
+// UpdateSettings changes the slot in which the logged user receives its digests
+func (s Service) UpdateSettings(ctx context.Context, timezone string, hour uint, weeklyDay time.Weekday) (model.User, error) {
+	user := model.ReadUser(ctx)
+	if user.IsZero() {
+		return model.User{}, httpModel.WrapInvalid(errors.New("you must be logged in for interacting"))
+	}
+
+	if err := checkSettings(timezone, hour, weeklyDay); err != nil {
+		return model.User{}, httpModel.WrapInvalid(err)
+	}
+
+	user.Timezone = timezone
+	user.Hour = hour
+	user.WeeklyDay = weeklyDay
+
+	if err := s.store.UpdateSettings(ctx, user); err != nil {
+		return model.User{}, httpModel.WrapInternal(fmt.Errorf("update settings: %w", err))
+	}
+
+	return user, nil
+}
+
+func checkSettings(timezone string, hour uint, weeklyDay time.Weekday) error {
+	var output []error
+
+	if len(strings.TrimSpace(timezone)) == 0 {
+		output = append(output, errors.New("timezone is required"))
+	} else if _, err := time.LoadLocation(timezone); err != nil {
+		output = append(output, fmt.Errorf("unknown timezone `%s`", timezone))
+	}
+
+	if hour > 23 {
+		output = append(output, errors.New("hour must be between 0 and 23"))
+	}
+
+	if weeklyDay < time.Sunday || weeklyDay > time.Saturday {
+		output = append(output, errors.New("unknown weekly day"))
+	}
+
+	return httpModel.ConcatError(output)
+}
+
 func (s Service) Count(ctx context.Context) (uint64, error) {
 	return s.store.Count(ctx)
 }
+
+func (s Service) GetByFeedToken(ctx context.Context, token string) (model.User, error) {
+	if len(token) == 0 {
+		return model.User{}, httpModel.WrapNotFound(errors.New("feed not found"))
+	}
+
+	item, err := s.store.GetByFeedToken(ctx, token)
+	if err != nil {
+		return model.User{}, httpModel.WrapInternal(fmt.Errorf("get by feed token: %w", err))
+	}
+
+	if item.IsZero() {
+		return model.User{}, httpModel.WrapNotFound(errors.New("feed not found"))
+	}
+
+	return item, nil
+}
+
+func (s Service) FeedToken(ctx context.Context) (string, error) {
+	token, err := s.store.GetFeedToken(ctx, model.ReadUser(ctx).ID)
+	if err != nil {
+		return "", httpModel.WrapInternal(fmt.Errorf("get feed token: %w", err))
+	}
+
+	return token, nil
+}
+
+// RegenerateFeedToken replaces the token of the feed, revoking the previous URL
+func (s Service) RegenerateFeedToken(ctx context.Context) (string, error) {
+	user := model.ReadUser(ctx)
+	if user.IsZero() {
+		return "", httpModel.WrapInvalid(errors.New("you must be logged in for interacting"))
+	}
+
+	raw := make([]byte, feedTokenLength)
+	if _, err := rand.Read(raw); err != nil {
+		return "", httpModel.WrapInternal(fmt.Errorf("generate feed token: %w", err))
+	}
+
+	token := hex.EncodeToString(raw)
+
+	if err := s.store.UpdateFeedToken(ctx, user.ID, token); err != nil {
+		return "", httpModel.WrapInternal(fmt.Errorf("update feed token: %w", err))
+	}
+
+	return token, nil
+}
@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	authModel "github.com/ViBiOh/auth/v3/pkg/model"
 	httpModel "github.com/ViBiOh/httputils/v4/pkg/model"
@@ -306,3 +307,176 @@ func TestCheck(t *testing.T) {
 		})
 	}
 }
+
+func TestGetByFeedToken(t *testing.T) {
+	t.Parallel()
+
+	user := model.NewUser(1, testEmail, authModel.NewUser("admin"))
+
+	type args struct {
+		token string
+	}
+
+	cases := map[string]struct {
+		args    args
+		want    model.User
+		wantErr error
+	}{
+		"empty": {
+			args{},
+			model.User{},
+			httpModel.ErrNotFound,
+		},
+		"error": {
+			args{
+				token: "secret",
+			},
+			model.User{},
+			httpModel.ErrInternalError,
+		},
+		"not found": {
+			args{
+				token: "secret",
+			},
+			model.User{},
+			httpModel.ErrNotFound,
+		},
+		"success": {
+			args{
+				token: "secret",
+			},
+			user,
+			nil,
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			mockUserStore := mocks.NewUserStore(ctrl)
+
+			instance := Service{
+				store: mockUserStore,
+			}
+
+			switch intention {
+			case "error":
+				mockUserStore.EXPECT().GetByFeedToken(gomock.Any(), "secret").Return(model.User{}, errors.New("failed"))
+			case "not found":
+				mockUserStore.EXPECT().GetByFeedToken(gomock.Any(), "secret").Return(model.User{}, nil)
+			case "success":
+				mockUserStore.EXPECT().GetByFeedToken(gomock.Any(), "secret").Return(user, nil)
+			}
+
+			got, gotErr := instance.GetByFeedToken(context.TODO(), testCase.args.token)
+
+			failed := false
+
+			if !errors.Is(gotErr, testCase.wantErr) {
+				failed = true
+			} else if !reflect.DeepEqual(got, testCase.want) {
+				failed = true
+			}
+
+			if failed {
+				t.Errorf("GetByFeedToken() = (%+v, `%s`), want (%+v, `%s`)", got, gotErr, testCase.want, testCase.wantErr)
+			}
+		})
+	}
+}
+
+func TestUpdateSettings(t *testing.T) {
+	t.Parallel()
+
+	user := model.NewUser(1, testEmail, authModel.NewUser("admin"))
+
+	type args struct {
+		ctx       context.Context
+		timezone  string
+		hour      uint
+		weeklyDay time.Weekday
+	}
+
+	cases := map[string]struct {
+		args    args
+		want    model.User
+		wantErr error
+	}{
+		"not logged": {
+			args{
+				ctx:      context.TODO(),
+				timezone: model.DefaultTimezone,
+			},
+			model.User{},
+			httpModel.ErrInvalid,
+		},
+		"invalid": {
+			args{
+				ctx:       model.StoreUser(context.TODO(), user),
+				timezone:  "Mars/Olympus",
+				hour:      24,
+				weeklyDay: 7,
+			},
+			model.User{},
+			httpModel.ErrInvalid,
+		},
+		"store error": {
+			args{
+				ctx:       model.StoreUser(context.TODO(), user),
+				timezone:  "America/New_York",
+				hour:      20,
+				weeklyDay: time.Sunday,
+			},
+			model.User{},
+			httpModel.ErrInternalError,
+		},
+		"success": {
+			args{
+				ctx:       model.StoreUser(context.TODO(), user),
+				timezone:  "America/New_York",
+				hour:      20,
+				weeklyDay: time.Sunday,
+			},
+			model.User{ID: 1, Email: testEmail, Base: user.Base, Timezone: "America/New_York", Hour: 20, WeeklyDay: time.Sunday},
+			nil,
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			mockUserStore := mocks.NewUserStore(ctrl)
+
+			instance := Service{
+				store: mockUserStore,
+			}
+
+			switch intention {
+			case "store error":
+				mockUserStore.EXPECT().UpdateSettings(gomock.Any(), gomock.Any()).Return(errors.New("failed"))
+			case "success":
+				mockUserStore.EXPECT().UpdateSettings(gomock.Any(), testCase.want).Return(nil)
+			}
+
+			got, gotErr := instance.UpdateSettings(testCase.args.ctx, testCase.args.timezone, testCase.args.hour, testCase.args.weeklyDay)
+
+			failed := false
+
+			if !errors.Is(gotErr, testCase.wantErr) {
+				failed = true
+			} else if !reflect.DeepEqual(got, testCase.want) {
+				failed = true
+			}
+
+			if failed {
+				t.Errorf("UpdateSettings() = (%+v, `%s`), want (%+v, `%s`)", got, gotErr, testCase.want, testCase.wantErr)
+			}
+		})
+	}
+}
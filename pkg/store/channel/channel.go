@@ -0,0 +1,114 @@
+package channel
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ViBiOh/ketchup/pkg/model"
+	"github.com/jackc/pgx/v5"
+)
+
+type Service struct {
+	db model.Database
+}
+
+func New(db model.Database) Service {
+	return Service{
+		db: db,
+	}
+}
+
+const listByUserQuery = `
+SELECT
+  id,
+  kind,
+  url,
+  secret,
+  frequencies::TEXT[]
+FROM
+  ketchup.notification_channel
+WHERE
+  user_id = $1
+ORDER BY
+  kind ASC,
+  id ASC
+`
+
+func (s Service) ListByUser(ctx context.Context, userID model.Identifier) ([]model.Channel, error) {
+	var list []model.Channel
+
+	scanner := func(rows pgx.Rows) error {
+		var item model.Channel
+		var rawChannelKind string
+		var rawFrequencies []string
+
+		if err := rows.Scan(&item.ID, &rawChannelKind, &item.URL, &item.Secret, &rawFrequencies); err != nil {
+			return err
+		}
+
+		channelKind, err := model.ParseChannelKind(rawChannelKind)
+		if err != nil {
+			return fmt.Errorf("parse kind `%s`: %w", rawChannelKind, err)
+		}
+
+		item.Kind = channelKind
+		item.User.ID = userID
+
+		for _, rawFrequency := range rawFrequencies {
+			frequency, err := model.ParseKetchupFrequency(rawFrequency)
+			if err != nil {
+				return fmt.Errorf("parse frequency `%s`: %w", rawFrequency, err)
+			}
+
+			item.Frequencies = append(item.Frequencies, frequency)
+		}
+
+		list = append(list, item)
+
+		return nil
+	}
+
+	return list, s.db.List(ctx, scanner, listByUserQuery, userID)
+}
+
+const insertQuery = `
+INSERT INTO
+  ketchup.notification_channel
+(
+  user_id,
+  kind,
+  url,
+  secret,
+  frequencies
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5::TEXT[]::ketchup.ketchup_frequency[]
+) RETURNING id
+`
+
+func (s Service) Create(ctx context.Context, o model.Channel) (model.Identifier, error) {
+	frequencies := make([]string, len(o.Frequencies))
+	for i, frequency := range o.Frequencies {
+		frequencies[i] = strings.ToLower(frequency.String())
+	}
+
+	id, err := s.db.Create(ctx, insertQuery, model.ReadUser(ctx).ID, strings.ToLower(o.Kind.String()), o.URL, o.Secret, frequencies)
+
+	return model.Identifier(id), err
+}
+
+const deleteQuery = `
+DELETE FROM
+  ketchup.notification_channel
+WHERE
+  id = $1
+  AND user_id = $2
+`
+
+func (s Service) Delete(ctx context.Context, o model.Channel) error {
+	return s.db.One(ctx, deleteQuery, o.ID, model.ReadUser(ctx).ID)
+}
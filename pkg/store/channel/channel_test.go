@@ -0,0 +1,116 @@
+package channel
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/ViBiOh/ketchup/pkg/mocks"
+	"github.com/ViBiOh/ketchup/pkg/model"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListByUser(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		userID model.Identifier
+	}
+
+	cases := map[string]struct {
+		args    args
+		want    []model.Channel
+		wantErr error
+	}{
+		"simple": {
+			args{
+				userID: 1,
+			},
+			[]model.Channel{
+				{ID: 1, Kind: model.Webhook, URL: "https://localhost/hook", Secret: "secret", User: model.User{ID: 1}, Frequencies: []model.KetchupFrequency{model.Daily, model.Weekly}},
+				{ID: 2, Kind: model.Slack, URL: "https://hooks.slack.com/services/T0/B0/X", User: model.User{ID: 1}, Frequencies: []model.KetchupFrequency{model.Weekly}},
+			},
+			nil,
+		},
+		"invalid kind": {
+			args{
+				userID: 1,
+			},
+			nil,
+			model.ErrUnknownChannelKind,
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			mockDatabase := mocks.NewDatabase(ctrl)
+
+			instance := Service{db: mockDatabase}
+
+			mockRows := mocks.NewRows(ctrl)
+			var rowsCount int
+
+			switch intention {
+			case "simple":
+				rowsCount = 2
+				mockRows.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(pointers ...any) error {
+					*pointers[0].(*model.Identifier) = 1
+					*pointers[1].(*string) = "webhook"
+					*pointers[2].(*string) = "https://localhost/hook"
+					*pointers[3].(*string) = "secret"
+					*pointers[4].(*[]string) = []string{"daily", "weekly"}
+
+					return nil
+				})
+				mockRows.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(pointers ...any) error {
+					*pointers[0].(*model.Identifier) = 2
+					*pointers[1].(*string) = "slack"
+					*pointers[2].(*string) = "https://hooks.slack.com/services/T0/B0/X"
+					*pointers[3].(*string) = ""
+					*pointers[4].(*[]string) = []string{"weekly"}
+
+					return nil
+				})
+			case "invalid kind":
+				rowsCount = 1
+				mockRows.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(pointers ...any) error {
+					*pointers[0].(*model.Identifier) = 1
+					*pointers[1].(*string) = "teams"
+
+					return nil
+				})
+			}
+
+			dummyFn := func(_ context.Context, scanner func(pgx.Rows) error, _ string, _ ...any) error {
+				for range rowsCount {
+					if err := scanner(mockRows); err != nil {
+						return err
+					}
+				}
+
+				return nil
+			}
+			mockDatabase.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any(), testCase.args.userID).DoAndReturn(dummyFn)
+
+			got, gotErr := instance.ListByUser(context.TODO(), testCase.args.userID)
+
+			failed := false
+
+			if !errors.Is(gotErr, testCase.wantErr) {
+				failed = true
+			} else if testCase.wantErr == nil && !reflect.DeepEqual(got, testCase.want) {
+				failed = true
+			}
+
+			if failed {
+				t.Errorf("ListByUser() = (%+v, `%s`), want (%+v, `%s`)", got, gotErr, testCase.want, testCase.wantErr)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/ViBiOh/ketchup/pkg/model"
 	"github.com/jackc/pgx/v5"
@@ -30,12 +31,16 @@ SELECT
   k.pattern,
   k.version,
   k.frequency,
+  k.severity,
+  k.cooldown,
   k.update_when_notify,
   k.repository_id,
   r.name,
   r.part,
   r.kind,
-  rv.version
+  rv.version,
+  k.snoozed_until,
+  k.skipped_version
 FROM
   ketchup.ketchup k,
   ketchup.repository r,
@@ -67,13 +72,18 @@ func (s Service) List(ctx context.Context, pageSize uint, last string) ([]model.
 		item := model.NewKetchup("", "", model.Daily, false, model.NewRepository(0, 0, "", ""))
 		item.User = user
 		var rawRepositoryKind string
-		var rawKetchupFrequency string
+		var rawKetchupFrequency, rawKetchupSeverity string
 		var repositoryVersion string
+		var snoozedUntil *time.Time
 
-		if err := rows.Scan(&item.Pattern, &item.Version, &rawKetchupFrequency, &item.UpdateWhenNotify, &item.Repository.ID, &item.Repository.Name, &item.Repository.Part, &rawRepositoryKind, &repositoryVersion); err != nil {
+		if err := rows.Scan(&item.Pattern, &item.Version, &rawKetchupFrequency, &rawKetchupSeverity, &item.Cooldown, &item.UpdateWhenNotify, &item.Repository.ID, &item.Repository.Name, &item.Repository.Part, &rawRepositoryKind, &repositoryVersion, &snoozedUntil, &item.SkippedVersion); err != nil {
 			return err
 		}
 
+		if snoozedUntil != nil {
+			item.SnoozedUntil = *snoozedUntil
+		}
+
 		item.Repository.AddVersion(item.Pattern, repositoryVersion)
 
 		ketchupFrequency, err := model.ParseKetchupFrequency(rawKetchupFrequency)
@@ -82,6 +92,12 @@ func (s Service) List(ctx context.Context, pageSize uint, last string) ([]model.
 		}
 		item.Frequency = ketchupFrequency
 
+		ketchupSeverity, err := model.ParseKetchupSeverity(rawKetchupSeverity)
+		if err != nil {
+			return fmt.Errorf("parse severity `%s`: %w", rawKetchupSeverity, err)
+		}
+		item.Severity = ketchupSeverity
+
 		repositoryKind, err := model.ParseRepositoryKind(rawRepositoryKind)
 		if err != nil {
 			return fmt.Errorf("parse kind `%s`: %w", rawRepositoryKind, err)
@@ -127,10 +143,18 @@ SELECT
   k.pattern,
   k.version,
   k.frequency,
+  k.severity,
+  k.cooldown,
   k.update_when_notify,
   k.repository_id,
   k.user_id,
-  u.email
+  u.email,
+  u.timezone,
+  u.notification_hour,
+  u.weekly_day,
+  k.snoozed_until,
+  k.skipped_version,
+  k.yank_notified_version
 FROM
   ketchup.ketchup k,
   ketchup.user u
@@ -146,18 +170,29 @@ func (s Service) ListByRepositoriesIDAndFrequencies(ctx context.Context, ids []m
 	scanner := func(rows pgx.Rows) error {
 		var item model.Ketchup
 		item.Repository = model.NewRepository(0, 0, "", "")
-		var rawKetchupFrequency string
+		var rawKetchupFrequency, rawKetchupSeverity string
+		var snoozedUntil *time.Time
 
-		if err := rows.Scan(&item.Pattern, &item.Version, &rawKetchupFrequency, &item.UpdateWhenNotify, &item.Repository.ID, &item.User.ID, &item.User.Email); err != nil {
+		if err := rows.Scan(&item.Pattern, &item.Version, &rawKetchupFrequency, &rawKetchupSeverity, &item.Cooldown, &item.UpdateWhenNotify, &item.Repository.ID, &item.User.ID, &item.User.Email, &item.User.Timezone, &item.User.Hour, &item.User.WeeklyDay, &snoozedUntil, &item.SkippedVersion, &item.YankNotifiedVersion); err != nil {
 			return err
 		}
 
+		if snoozedUntil != nil {
+			item.SnoozedUntil = *snoozedUntil
+		}
+
 		ketchupFrequency, err := model.ParseKetchupFrequency(rawKetchupFrequency)
 		if err != nil {
 			return fmt.Errorf("parse frequency `%s`: %w", rawKetchupFrequency, err)
 		}
 		item.Frequency = ketchupFrequency
 
+		ketchupSeverity, err := model.ParseKetchupSeverity(rawKetchupSeverity)
+		if err != nil {
+			return fmt.Errorf("parse severity `%s`: %w", rawKetchupSeverity, err)
+		}
+		item.Severity = ketchupSeverity
+
 		list = append(list, item)
 		return nil
 	}
@@ -172,16 +207,23 @@ func (s Service) ListByRepositoriesIDAndFrequencies(ctx context.Context, ids []m
 
 const listOutdatedByFrequencyQuery = `
 SELECT
-  rv.pattern,
-  rv.version,
+  k.pattern,
+  k.version,
   k.frequency,
   k.update_when_notify,
   r.id,
   r.name,
   r.part,
   r.kind,
+  rv.version,
   k.user_id,
-  u.email
+  u.email,
+  u.timezone,
+  u.notification_hour,
+  u.weekly_day,
+  k.snoozed_until,
+  k.skipped_version,
+  k.reminded_at
 FROM
   ketchup.ketchup AS k
 INNER JOIN
@@ -190,22 +232,33 @@ INNER JOIN
   ketchup.repository_version AS rv ON rv.repository_id = k.repository_id AND rv.pattern = k.pattern
 INNER JOIN
   ketchup.user AS u ON u.id = k.user_id
+LEFT JOIN
+  ketchup.release AS rl ON rl.repository_id = k.repository_id AND rl.pattern = k.pattern AND rl.version = rv.version
 WHERE
   k.version <> rv.version
+  AND COALESCE(rl.published_at, rl.detected_at, '-infinity') <= now() - k.cooldown * INTERVAL '1 day'
 `
 
+// ListOutdated lists the ketchups behind the latest version of their pattern, given in their repository's versions
 func (s Service) ListOutdated(ctx context.Context, userIds ...model.Identifier) ([]model.Ketchup, error) {
 	var list []model.Ketchup
 
 	scanner := func(rows pgx.Rows) error {
 		var item model.Ketchup
 		item.Repository = model.NewRepository(0, 0, "", "")
-		var rawKetchupFrequency, rawRepositoryKind string
+		var rawKetchupFrequency, rawRepositoryKind, repositoryVersion string
+		var snoozedUntil *time.Time
 
-		if err := rows.Scan(&item.Pattern, &item.Version, &rawKetchupFrequency, &item.UpdateWhenNotify, &item.Repository.ID, &item.Repository.Name, &item.Repository.Part, &rawRepositoryKind, &item.User.ID, &item.User.Email); err != nil {
+		if err := rows.Scan(&item.Pattern, &item.Version, &rawKetchupFrequency, &item.UpdateWhenNotify, &item.Repository.ID, &item.Repository.Name, &item.Repository.Part, &rawRepositoryKind, &repositoryVersion, &item.User.ID, &item.User.Email, &item.User.Timezone, &item.User.Hour, &item.User.WeeklyDay, &snoozedUntil, &item.SkippedVersion, &item.RemindedAt); err != nil {
 			return err
 		}
 
+		if snoozedUntil != nil {
+			item.SnoozedUntil = *snoozedUntil
+		}
+
+		item.Repository.AddVersion(item.Pattern, repositoryVersion)
+
 		ketchupFrequency, err := model.ParseKetchupFrequency(rawKetchupFrequency)
 		if err != nil {
 			return fmt.Errorf("parse frequency `%s`: %w", rawKetchupFrequency, err)
@@ -233,6 +286,94 @@ func (s Service) ListOutdated(ctx context.Context, userIds ...model.Identifier)
 	return list, s.db.List(ctx, scanner, query, params...)
 }
 
+const listMaturedQuery = `
+SELECT
+  k.pattern,
+  k.version,
+  k.frequency,
+  k.severity,
+  k.cooldown,
+  k.update_when_notify,
+  r.id,
+  r.name,
+  r.part,
+  r.kind,
+  rv.version,
+  k.user_id,
+  u.email,
+  u.timezone,
+  u.notification_hour,
+  u.weekly_day,
+  k.snoozed_until,
+  k.skipped_version
+FROM
+  ketchup.ketchup AS k
+INNER JOIN
+  ketchup.repository r ON r.id = k.repository_id
+INNER JOIN
+  ketchup.repository_version AS rv ON rv.repository_id = k.repository_id AND rv.pattern = k.pattern
+INNER JOIN
+  ketchup.user AS u ON u.id = k.user_id
+LEFT JOIN
+  ketchup.release AS rl ON rl.repository_id = k.repository_id AND rl.pattern = k.pattern AND rl.version = rv.version
+WHERE
+  k.cooldown > 0
+  AND k.frequency = ANY ($2)
+  AND k.version <> rv.version
+  AND k.notified_version <> rv.version
+  AND COALESCE(rl.published_at, rl.detected_at, '-infinity') <= $1 - k.cooldown * INTERVAL '1 day'
+`
+
+// ListMatured lists the ketchups with a cooldown whose latest release has been public long enough and hasn't been notified yet
+func (s Service) ListMatured(ctx context.Context, now time.Time, frequencies ...model.KetchupFrequency) ([]model.Ketchup, error) {
+	var list []model.Ketchup
+
+	scanner := func(rows pgx.Rows) error {
+		var item model.Ketchup
+		item.Repository = model.NewRepository(0, 0, "", "")
+		var rawKetchupFrequency, rawKetchupSeverity, rawRepositoryKind, repositoryVersion string
+		var snoozedUntil *time.Time
+
+		if err := rows.Scan(&item.Pattern, &item.Version, &rawKetchupFrequency, &rawKetchupSeverity, &item.Cooldown, &item.UpdateWhenNotify, &item.Repository.ID, &item.Repository.Name, &item.Repository.Part, &rawRepositoryKind, &repositoryVersion, &item.User.ID, &item.User.Email, &item.User.Timezone, &item.User.Hour, &item.User.WeeklyDay, &snoozedUntil, &item.SkippedVersion); err != nil {
+			return err
+		}
+
+		if snoozedUntil != nil {
+			item.SnoozedUntil = *snoozedUntil
+		}
+
+		item.Repository.AddVersion(item.Pattern, repositoryVersion)
+
+		ketchupFrequency, err := model.ParseKetchupFrequency(rawKetchupFrequency)
+		if err != nil {
+			return fmt.Errorf("parse frequency `%s`: %w", rawKetchupFrequency, err)
+		}
+		item.Frequency = ketchupFrequency
+
+		ketchupSeverity, err := model.ParseKetchupSeverity(rawKetchupSeverity)
+		if err != nil {
+			return fmt.Errorf("parse severity `%s`: %w", rawKetchupSeverity, err)
+		}
+		item.Severity = ketchupSeverity
+
+		repositoryKind, err := model.ParseRepositoryKind(rawRepositoryKind)
+		if err != nil {
+			return fmt.Errorf("parse kind `%s`: %w", rawRepositoryKind, err)
+		}
+		item.Repository.Kind = repositoryKind
+
+		list = append(list, item)
+		return nil
+	}
+
+	frequenciesStr := make([]string, len(frequencies))
+	for i, frequency := range frequencies {
+		frequenciesStr[i] = strings.ToLower(frequency.String())
+	}
+
+	return list, s.db.List(ctx, scanner, listMaturedQuery, now, frequenciesStr)
+}
+
 const listSilentForRepositoriesQuery = `
 SELECT
   k.pattern,
@@ -241,7 +382,10 @@ SELECT
   k.update_when_notify,
   k.repository_id,
   k.user_id,
-  u.email
+  u.email,
+  u.timezone,
+  u.notification_hour,
+  u.weekly_day
 FROM
   ketchup.ketchup k,
   ketchup.user u
@@ -259,7 +403,7 @@ func (s Service) ListSilentForRepositories(ctx context.Context, ids []uint64) ([
 		item.Repository = model.NewRepository(0, 0, "", "")
 		var rawKetchupFrequency string
 
-		if err := rows.Scan(&item.Pattern, &item.Version, &rawKetchupFrequency, &item.UpdateWhenNotify, &item.Repository.ID, &item.User.ID, &item.User.Email); err != nil {
+		if err := rows.Scan(&item.Pattern, &item.Version, &rawKetchupFrequency, &item.UpdateWhenNotify, &item.Repository.ID, &item.User.ID, &item.User.Email, &item.User.Timezone, &item.User.Hour, &item.User.WeeklyDay); err != nil {
 			return err
 		}
 
@@ -281,6 +425,8 @@ SELECT
   k.pattern,
   k.version,
   k.frequency,
+  k.severity,
+  k.cooldown,
   k.update_when_notify,
   k.repository_id,
   k.user_id,
@@ -310,9 +456,9 @@ func (s Service) GetByRepository(ctx context.Context, id model.Identifier, patte
 	}
 
 	scanner := func(row pgx.Row) error {
-		var rawRepositoryKind, rawKetchupFrequency string
+		var rawRepositoryKind, rawKetchupFrequency, rawKetchupSeverity string
 
-		err := row.Scan(&item.Pattern, &item.Version, &rawKetchupFrequency, &item.UpdateWhenNotify, &item.Repository.ID, &item.User.ID, &item.Repository.Name, &item.Repository.Part, &rawRepositoryKind)
+		err := row.Scan(&item.Pattern, &item.Version, &rawKetchupFrequency, &rawKetchupSeverity, &item.Cooldown, &item.UpdateWhenNotify, &item.Repository.ID, &item.User.ID, &item.Repository.Name, &item.Repository.Part, &rawRepositoryKind)
 		if errors.Is(err, pgx.ErrNoRows) {
 			item = model.Ketchup{}
 			return nil
@@ -324,6 +470,12 @@ func (s Service) GetByRepository(ctx context.Context, id model.Identifier, patte
 		}
 		item.Frequency = ketchupFrequency
 
+		ketchupSeverity, err := model.ParseKetchupSeverity(rawKetchupSeverity)
+		if err != nil {
+			return fmt.Errorf("parse severity `%s`: %w", rawKetchupSeverity, err)
+		}
+		item.Severity = ketchupSeverity
+
 		repositoryKind, err := model.ParseRepositoryKind(rawRepositoryKind)
 		if err != nil {
 			return fmt.Errorf("parse kind `%s`: %w", rawRepositoryKind, err)
@@ -345,19 +497,23 @@ INSERT INTO
   frequency,
   update_when_notify,
   repository_id,
-  user_id
+  user_id,
+  cooldown,
+  severity
 ) VALUES (
   $1,
   $2,
   $3,
   $4,
   $5,
-  $6
+  $6,
+  $7,
+  $8
 ) RETURNING 1
 `
 
 func (s Service) Create(ctx context.Context, o model.Ketchup) (model.Identifier, error) {
-	id, err := s.db.Create(ctx, insertQuery, o.Pattern, o.Version, strings.ToLower(o.Frequency.String()), o.UpdateWhenNotify, o.Repository.ID, model.ReadUser(ctx).ID)
+	id, err := s.db.Create(ctx, insertQuery, o.Pattern, o.Version, strings.ToLower(o.Frequency.String()), o.UpdateWhenNotify, o.Repository.ID, model.ReadUser(ctx).ID, o.Cooldown, strings.ToLower(o.Severity.String()))
 
 	return model.Identifier(id), err
 }
@@ -369,7 +525,9 @@ SET
   pattern = $4,
   version = $5,
   frequency = $6,
-  update_when_notify = $7
+  update_when_notify = $7,
+  cooldown = $8,
+  severity = $9
 WHERE
   repository_id = $1
   AND user_id = $2
@@ -377,7 +535,7 @@ WHERE
 `
 
 func (s Service) Update(ctx context.Context, o model.Ketchup, oldPattern string) error {
-	return s.db.One(ctx, updateQuery, o.Repository.ID, model.ReadUser(ctx).ID, oldPattern, o.Pattern, o.Version, strings.ToLower(o.Frequency.String()), o.UpdateWhenNotify)
+	return s.db.One(ctx, updateQuery, o.Repository.ID, model.ReadUser(ctx).ID, oldPattern, o.Pattern, o.Version, strings.ToLower(o.Frequency.String()), o.UpdateWhenNotify, o.Cooldown, strings.ToLower(o.Severity.String()))
 }
 
 const updateAllQuery = `
@@ -415,6 +573,105 @@ func (s Service) UpdateVersion(ctx context.Context, userID, repositoryID model.I
 	return s.db.One(ctx, updateVersionQuery, repositoryID, userID, pattern, version)
 }
 
+const updateNotifiedVersionQuery = `
+UPDATE
+  ketchup.ketchup
+SET
+  notified_version = $4
+WHERE
+  repository_id = $1
+  AND user_id = $2
+  AND pattern = $3
+`
+
+func (s Service) UpdateNotifiedVersion(ctx context.Context, o model.Ketchup, version string) error {
+	return s.db.One(ctx, updateNotifiedVersionQuery, o.Repository.ID, o.User.ID, o.Pattern, version)
+}
+
+const updateYankNotifiedVersionQuery = `
+UPDATE
+  ketchup.ketchup
+SET
+  yank_notified_version = $4
+WHERE
+  repository_id = $1
+  AND user_id = $2
+  AND pattern = $3
+`
+
+// UpdateYankNotifiedVersion records the yanked version the user was warned about, for warning only once
+func (s Service) UpdateYankNotifiedVersion(ctx context.Context, o model.Ketchup, version string) error {
+	return s.db.One(ctx, updateYankNotifiedVersionQuery, o.Repository.ID, o.User.ID, o.Pattern, version)
+}
+
+const updateRemindedAtQuery = `
+UPDATE
+  ketchup.ketchup
+SET
+  reminded_at = $4
+WHERE
+  repository_id = $1
+  AND user_id = $2
+  AND pattern = $3
+`
+
+// UpdateRemindedAt records when the outdated ketchup was last reminded, for reminding it once per slot
+func (s Service) UpdateRemindedAt(ctx context.Context, o model.Ketchup, remindedAt time.Time) error {
+	return s.db.One(ctx, updateRemindedAtQuery, o.Repository.ID, o.User.ID, o.Pattern, remindedAt)
+}
+
+const snoozeQuery = `
+UPDATE
+  ketchup.ketchup
+SET
+  snoozed_until = $4
+WHERE
+  repository_id = $1
+  AND user_id = $2
+  AND pattern = $3
+`
+
+// Snooze postpones the notifications of the ketchup until the given time, a zero time clearing it
+func (s Service) Snooze(ctx context.Context, userID, repositoryID model.Identifier, pattern string, until time.Time) error {
+	var snoozedUntil *time.Time
+	if !until.IsZero() {
+		snoozedUntil = &until
+	}
+
+	return s.db.One(ctx, snoozeQuery, repositoryID, userID, pattern, snoozedUntil)
+}
+
+const skipVersionQuery = `
+UPDATE
+  ketchup.ketchup
+SET
+  skipped_version = $4
+WHERE
+  repository_id = $1
+  AND user_id = $2
+  AND pattern = $3
+`
+
+// SkipVersion silences the notifications of the given upstream version, an empty version clearing it
+func (s Service) SkipVersion(ctx context.Context, userID, repositoryID model.Identifier, pattern, version string) error {
+	return s.db.One(ctx, skipVersionQuery, repositoryID, userID, pattern, version)
+}
+
+const updateFrequencyQuery = `
+UPDATE
+  ketchup.ketchup
+SET
+  frequency = $4
+WHERE
+  repository_id = $1
+  AND user_id = $2
+  AND pattern = $3
+`
+
+func (s Service) UpdateFrequency(ctx context.Context, userID, repositoryID model.Identifier, pattern string, frequency model.KetchupFrequency) error {
+	return s.db.One(ctx, updateFrequencyQuery, repositoryID, userID, pattern, strings.ToLower(frequency.String()))
+}
+
 const deleteQuery = `
 DELETE FROM
   ketchup.ketchup
@@ -49,7 +49,7 @@ func TestList(t *testing.T) {
 			},
 			[]model.Ketchup{
 				{
-					ID:         "3b20eb15",
+					ID:         "da9aab0f",
 					Pattern:    model.DefaultPattern,
 					Version:    "0.9.0",
 					Frequency:  model.Daily,
@@ -57,7 +57,7 @@ func TestList(t *testing.T) {
 					User:       model.NewUser(3, testEmail, loginUser),
 				},
 				{
-					ID:         "adb2e660",
+					ID:         "c04c8540",
 					Pattern:    model.DefaultPattern,
 					Version:    repositoryVersion,
 					Frequency:  model.Daily,
@@ -96,29 +96,31 @@ func TestList(t *testing.T) {
 			switch intention {
 			case "simple":
 				mockRows := mocks.NewRows(ctrl)
-				mockRows.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(pointers ...any) error {
+				mockRows.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(pointers ...any) error {
 					*pointers[0].(*string) = model.DefaultPattern
 					*pointers[1].(*string) = "0.9.0"
 					*pointers[2].(*string) = "daily"
-					*pointers[3].(*bool) = false
-					*pointers[4].(*model.Identifier) = model.Identifier(1)
-					*pointers[5].(*string) = repositoryName
-					*pointers[6].(*string) = ""
-					*pointers[7].(*string) = "github"
-					*pointers[8].(*string) = repositoryVersion
+					*pointers[3].(*string) = "any"
+					*pointers[5].(*bool) = false
+					*pointers[6].(*model.Identifier) = model.Identifier(1)
+					*pointers[7].(*string) = repositoryName
+					*pointers[8].(*string) = ""
+					*pointers[9].(*string) = "github"
+					*pointers[10].(*string) = repositoryVersion
 
 					return nil
 				})
-				mockRows.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(pointers ...any) error {
+				mockRows.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(pointers ...any) error {
 					*pointers[0].(*string) = model.DefaultPattern
 					*pointers[1].(*string) = repositoryVersion
 					*pointers[2].(*string) = "daily"
-					*pointers[3].(*bool) = false
-					*pointers[4].(*model.Identifier) = model.Identifier(2)
-					*pointers[5].(*string) = chartRepository
-					*pointers[6].(*string) = "app"
-					*pointers[7].(*string) = "helm"
-					*pointers[8].(*string) = repositoryVersion
+					*pointers[3].(*string) = "any"
+					*pointers[5].(*bool) = false
+					*pointers[6].(*model.Identifier) = model.Identifier(2)
+					*pointers[7].(*string) = chartRepository
+					*pointers[8].(*string) = "app"
+					*pointers[9].(*string) = "helm"
+					*pointers[10].(*string) = repositoryVersion
 
 					return nil
 				})
@@ -135,16 +137,17 @@ func TestList(t *testing.T) {
 
 			case "invalid kind":
 				mockRows := mocks.NewRows(ctrl)
-				mockRows.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(pointers ...any) error {
+				mockRows.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(pointers ...any) error {
 					*pointers[0].(*string) = model.DefaultPattern
 					*pointers[1].(*string) = "0.9.0"
 					*pointers[2].(*string) = "daily"
-					*pointers[3].(*bool) = false
-					*pointers[4].(*model.Identifier) = model.Identifier(1)
-					*pointers[5].(*string) = repositoryName
-					*pointers[6].(*string) = ""
-					*pointers[7].(*string) = "wrong"
-					*pointers[8].(*string) = repositoryVersion
+					*pointers[3].(*string) = "any"
+					*pointers[5].(*bool) = false
+					*pointers[6].(*model.Identifier) = model.Identifier(1)
+					*pointers[7].(*string) = repositoryName
+					*pointers[8].(*string) = ""
+					*pointers[9].(*string) = "wrong"
+					*pointers[10].(*string) = repositoryVersion
 
 					return nil
 				})
@@ -236,25 +239,27 @@ func TestListByRepositoriesIDAndFrequencies(t *testing.T) {
 			switch intention {
 			case "simple":
 				mockRows := mocks.NewRows(ctrl)
-				mockRows.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(pointers ...any) error {
+				mockRows.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(pointers ...any) error {
 					*pointers[0].(*string) = model.DefaultPattern
 					*pointers[1].(*string) = "0.9.0"
 					*pointers[2].(*string) = "daily"
-					*pointers[3].(*bool) = false
-					*pointers[4].(*model.Identifier) = model.Identifier(1)
-					*pointers[5].(*model.Identifier) = model.Identifier(1)
-					*pointers[6].(*string) = testEmail
+					*pointers[3].(*string) = "any"
+					*pointers[5].(*bool) = false
+					*pointers[6].(*model.Identifier) = model.Identifier(1)
+					*pointers[7].(*model.Identifier) = model.Identifier(1)
+					*pointers[8].(*string) = testEmail
 
 					return nil
 				})
-				mockRows.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(pointers ...any) error {
+				mockRows.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(pointers ...any) error {
 					*pointers[0].(*string) = model.DefaultPattern
 					*pointers[1].(*string) = repositoryVersion
 					*pointers[2].(*string) = "daily"
-					*pointers[3].(*bool) = false
-					*pointers[4].(*model.Identifier) = model.Identifier(2)
-					*pointers[5].(*model.Identifier) = model.Identifier(2)
-					*pointers[6].(*string) = "guest@domain"
+					*pointers[3].(*string) = "any"
+					*pointers[5].(*bool) = false
+					*pointers[6].(*model.Identifier) = model.Identifier(2)
+					*pointers[7].(*model.Identifier) = model.Identifier(2)
+					*pointers[8].(*string) = "guest@domain"
 
 					return nil
 				})
@@ -342,16 +347,17 @@ func TestGetByRepository(t *testing.T) {
 			switch intention {
 			case "simple":
 				mockRow := mocks.NewRow(ctrl)
-				mockRow.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(pointers ...any) error {
+				mockRow.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(pointers ...any) error {
 					*pointers[0].(*string) = model.DefaultPattern
 					*pointers[1].(*string) = "0.9.0"
 					*pointers[2].(*string) = "daily"
-					*pointers[3].(*bool) = false
-					*pointers[4].(*model.Identifier) = model.Identifier(1)
-					*pointers[5].(*model.Identifier) = model.Identifier(3)
-					*pointers[6].(*string) = repositoryName
-					*pointers[7].(*string) = ""
-					*pointers[8].(*string) = "github"
+					*pointers[3].(*string) = "any"
+					*pointers[5].(*bool) = false
+					*pointers[6].(*model.Identifier) = model.Identifier(1)
+					*pointers[7].(*model.Identifier) = model.Identifier(3)
+					*pointers[8].(*string) = repositoryName
+					*pointers[9].(*string) = ""
+					*pointers[10].(*string) = "github"
 
 					return nil
 				})
@@ -361,7 +367,7 @@ func TestGetByRepository(t *testing.T) {
 				mockDatabase.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any(), testCase.args.id, model.Identifier(3), testCase.args.pattern).DoAndReturn(dummyFn)
 			case "no rows":
 				mockRow := mocks.NewRow(ctrl)
-				mockRow.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(pointers ...any) error {
+				mockRow.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(pointers ...any) error {
 					return pgx.ErrNoRows
 				})
 				dummyFn := func(_ context.Context, scanner func(pgx.Row) error, _ string, _ ...any) error {
@@ -427,7 +433,7 @@ func TestCreate(t *testing.T) {
 
 			switch intention {
 			case "simple":
-				mockDatabase.EXPECT().Create(gomock.Any(), gomock.Any(), model.DefaultPattern, "0.9.0", "daily", gomock.Any(), model.Identifier(1), model.Identifier(3)).Return(uint64(1), nil)
+				mockDatabase.EXPECT().Create(gomock.Any(), gomock.Any(), model.DefaultPattern, "0.9.0", "daily", gomock.Any(), model.Identifier(1), model.Identifier(3), uint(0), "any").Return(uint64(1), nil)
 			}
 
 			got, gotErr := instance.Create(testCtx, testCase.args.o)
@@ -485,7 +491,7 @@ func TestUpdate(t *testing.T) {
 
 			switch intention {
 			case "simple":
-				mockDatabase.EXPECT().One(gomock.Any(), gomock.Any(), model.Identifier(1), model.Identifier(3), model.DefaultPattern, model.DefaultPattern, "0.9.0", "daily", gomock.Any()).Return(nil)
+				mockDatabase.EXPECT().One(gomock.Any(), gomock.Any(), model.Identifier(1), model.Identifier(3), model.DefaultPattern, model.DefaultPattern, "0.9.0", "daily", gomock.Any(), uint(0), "any").Return(nil)
 			}
 
 			gotErr := instance.Update(testCtx, testCase.args.o, testCase.args.oldPattern)
@@ -0,0 +1,269 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ViBiOh/ketchup/pkg/model"
+	"github.com/jackc/pgx/v5"
+)
+
+type Service struct {
+	db model.Database
+}
+
+func New(db model.Database) Service {
+	return Service{
+		db: db,
+	}
+}
+
+// storedRelease keeps what the JSON of a release loses: the parsed version is rebuilt from its name
+type storedRelease struct {
+	model.Release
+	Frequency string `json:"frequency"`
+	Yanked    bool   `json:"yanked,omitempty"`
+}
+
+func (s Service) DoAtomic(ctx context.Context, action func(context.Context) error) error {
+	return s.db.DoAtomic(ctx, action)
+}
+
+const insertQuery = `
+INSERT INTO
+  ketchup.notification
+(
+  user_id,
+  channel_id,
+  kind,
+  releases,
+  status,
+  scheduled_at
+) VALUES (
+  $1,
+  NULLIF($2::BIGINT, 0),
+  $3,
+  $4,
+  $5,
+  $6
+) RETURNING id
+`
+
+func (s Service) Create(ctx context.Context, o model.Notification) (model.Identifier, error) {
+	payload, err := marshalReleases(o.Releases)
+	if err != nil {
+		return 0, fmt.Errorf("marshal releases: %w", err)
+	}
+
+	id, err := s.db.Create(ctx, insertQuery, o.Channel.User.ID, o.Channel.ID, strings.ToLower(o.Channel.Kind.String()), payload, strings.ToLower(o.Status.String()), o.ScheduledAt)
+
+	return model.Identifier(id), err
+}
+
+const getPendingQuery = `
+SELECT
+  id,
+  releases
+FROM
+  ketchup.notification
+WHERE
+  user_id = $1
+  AND COALESCE(channel_id, 0) = $2
+  AND kind = $3
+  AND scheduled_at = $4
+  AND status = 'pending'
+  AND attempts = 0
+FOR UPDATE
+`
+
+// GetPending finds the notification not yet sent to the same channel at the same time, zero if there is none
+func (s Service) GetPending(ctx context.Context, o model.Notification) (model.Notification, error) {
+	var id model.Identifier
+	var payload []byte
+
+	scanner := func(row pgx.Row) (err error) {
+		switch err = row.Scan(&id, &payload); err {
+		case pgx.ErrNoRows:
+			return nil
+		}
+
+		return err
+	}
+
+	if err := s.db.Get(ctx, scanner, getPendingQuery, o.Channel.User.ID, o.Channel.ID, strings.ToLower(o.Channel.Kind.String()), o.ScheduledAt); err != nil || id.IsZero() {
+		return model.Notification{}, err
+	}
+
+	releases, err := unmarshalReleases(payload)
+	if err != nil {
+		return model.Notification{}, fmt.Errorf("unmarshal releases of notification %d: %w", id, err)
+	}
+
+	o.ID = id
+	o.Releases = releases
+
+	return o, nil
+}
+
+const listDueQuery = `
+SELECT
+  n.id,
+  n.scheduled_at,
+  n.status,
+  n.attempts,
+  n.error,
+  n.releases,
+  u.id,
+  u.email,
+  COALESCE(c.id, 0),
+  n.kind,
+  COALESCE(c.url, ''),
+  COALESCE(c.secret, ''),
+  COALESCE(c.frequencies::TEXT[], '{}')
+FROM
+  ketchup.notification n
+  JOIN ketchup.user u ON u.id = n.user_id
+  LEFT JOIN ketchup.notification_channel c ON c.id = n.channel_id
+WHERE
+  n.status <> 'delivered'
+  AND n.attempts < $1
+  AND n.scheduled_at <= $2
+ORDER BY
+  n.id ASC
+`
+
+func (s Service) ListDue(ctx context.Context, now time.Time, maxAttempts uint) ([]model.Notification, error) {
+	var list []model.Notification
+
+	scanner := func(rows pgx.Rows) error {
+		var item model.Notification
+		var rawStatus, rawChannelKind string
+		var rawFrequencies []string
+		var payload []byte
+
+		if err := rows.Scan(&item.ID, &item.ScheduledAt, &rawStatus, &item.Attempts, &item.Error, &payload, &item.Channel.User.ID, &item.Channel.User.Email, &item.Channel.ID, &rawChannelKind, &item.Channel.URL, &item.Channel.Secret, &rawFrequencies); err != nil {
+			return err
+		}
+
+		status, err := model.ParseNotificationStatus(rawStatus)
+		if err != nil {
+			return fmt.Errorf("parse status `%s`: %w", rawStatus, err)
+		}
+
+		item.Status = status
+
+		channelKind, err := model.ParseChannelKind(rawChannelKind)
+		if err != nil {
+			return fmt.Errorf("parse kind `%s`: %w", rawChannelKind, err)
+		}
+
+		item.Channel.Kind = channelKind
+
+		if item.Channel.IsZero() {
+			item.Channel = model.NewEmailChannel(item.Channel.User)
+		}
+
+		for _, rawFrequency := range rawFrequencies {
+			frequency, err := model.ParseKetchupFrequency(rawFrequency)
+			if err != nil {
+				return fmt.Errorf("parse frequency `%s`: %w", rawFrequency, err)
+			}
+
+			item.Channel.Frequencies = append(item.Channel.Frequencies, frequency)
+		}
+
+		if item.Releases, err = unmarshalReleases(payload); err != nil {
+			return fmt.Errorf("unmarshal releases of notification %d: %w", item.ID, err)
+		}
+
+		list = append(list, item)
+
+		return nil
+	}
+
+	return list, s.db.List(ctx, scanner, listDueQuery, maxAttempts, now)
+}
+
+const updateQuery = `
+UPDATE
+  ketchup.notification
+SET
+  status = $2,
+  attempts = $3,
+  error = $4,
+  update_date = now()
+WHERE
+  id = $1
+`
+
+const updateReleasesQuery = `
+UPDATE
+  ketchup.notification
+SET
+  releases = $2,
+  update_date = now()
+WHERE
+  id = $1
+`
+
+func (s Service) UpdateReleases(ctx context.Context, o model.Notification) error {
+	payload, err := marshalReleases(o.Releases)
+	if err != nil {
+		return fmt.Errorf("marshal releases: %w", err)
+	}
+
+	return s.db.One(ctx, updateReleasesQuery, o.ID, payload)
+}
+
+func (s Service) Update(ctx context.Context, o model.Notification) error {
+	return s.db.One(ctx, updateQuery, o.ID, strings.ToLower(o.Status.String()), o.Attempts, o.Error)
+}
+
+func marshalReleases(releases []model.Release) ([]byte, error) {
+	stored := make([]storedRelease, len(releases))
+
+	for index, release := range releases {
+		stored[index] = storedRelease{
+			Release:   release,
+			Frequency: strings.ToLower(release.Frequency.String()),
+			Yanked:    release.Version.IsYanked(),
+		}
+	}
+
+	return json.Marshal(stored)
+}
+
+func unmarshalReleases(payload []byte) ([]model.Release, error) {
+	var stored []storedRelease
+	if err := json.Unmarshal(payload, &stored); err != nil {
+		return nil, err
+	}
+
+	releases := make([]model.Release, len(stored))
+
+	for index, item := range stored {
+		release := item.Release
+
+		version, err := release.Repository.ParseVersion(release.Version.Name)
+		if err != nil {
+			return nil, fmt.Errorf("parse version `%s`: %w", release.Version.Name, err)
+		}
+
+		if item.Yanked {
+			version = version.Yank()
+		}
+
+		release.Version = version
+
+		if release.Frequency, err = model.ParseKetchupFrequency(item.Frequency); err != nil {
+			return nil, fmt.Errorf("parse frequency `%s`: %w", item.Frequency, err)
+		}
+
+		releases[index] = release
+	}
+
+	return releases, nil
+}
@@ -0,0 +1,185 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ViBiOh/ketchup/pkg/mocks"
+	"github.com/ViBiOh/ketchup/pkg/model"
+	"github.com/ViBiOh/ketchup/pkg/semver"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/mock/gomock"
+)
+
+func safeParse(version string) semver.Version {
+	output, err := semver.Parse(version, "")
+	if err != nil {
+		panic(err)
+	}
+
+	return output
+}
+
+func TestMarshalReleases(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		releases []model.Release
+	}{
+		"empty": {
+			[]model.Release{},
+		},
+		"round trip": {
+			[]model.Release{
+				model.NewRelease(model.NewGithubRepository(1, "vibioh/ketchup").AddVersion(model.DefaultPattern, "1.1.0"), model.DefaultPattern, safeParse("1.1.0")).SetCurrent("1.0.0").SetFrequency(model.Daily),
+				model.NewRelease(model.NewRepository(2, model.NPM, "left-pad", ""), model.DefaultPattern, safeParse("1.0.0").Yank()).SetCurrent("1.0.0").SetFrequency(model.Weekly),
+			},
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			payload, err := marshalReleases(testCase.releases)
+			if err != nil {
+				t.Fatalf("marshalReleases() = `%s`", err)
+			}
+
+			got, err := unmarshalReleases(payload)
+			if err != nil {
+				t.Fatalf("unmarshalReleases() = `%s`", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.releases) {
+				t.Errorf("unmarshalReleases(marshalReleases()) = %+v, want %+v", got, testCase.releases)
+			}
+		})
+	}
+}
+
+func TestListDue(t *testing.T) {
+	t.Parallel()
+
+	user := model.User{ID: 1, Email: "nobody@localhost"}
+
+	now := time.Date(2021, 1, 1, 8, 0, 0, 0, time.UTC)
+
+	type args struct {
+		now         time.Time
+		maxAttempts uint
+	}
+
+	cases := map[string]struct {
+		args    args
+		want    []model.Notification
+		wantErr error
+	}{
+		"simple": {
+			args{
+				now:         now,
+				maxAttempts: 3,
+			},
+			[]model.Notification{
+				{ID: 1, ScheduledAt: now, Status: model.Pending, Channel: model.NewEmailChannel(user), Releases: []model.Release{}},
+				{ID: 2, Status: model.Failed, Attempts: 1, Error: "timeout", Channel: model.Channel{ID: 3, Kind: model.Slack, URL: "https://hooks.slack.com/services/T0/B0/X", User: user, Frequencies: []model.KetchupFrequency{model.Daily}}, Releases: []model.Release{}},
+			},
+			nil,
+		},
+		"invalid status": {
+			args{
+				now:         now,
+				maxAttempts: 3,
+			},
+			nil,
+			model.ErrUnknownNotificationStatus,
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			mockDatabase := mocks.NewDatabase(ctrl)
+
+			instance := Service{db: mockDatabase}
+
+			mockRows := mocks.NewRows(ctrl)
+			var rowsCount int
+
+			scanArgs := make([]any, 13)
+			for index := range scanArgs {
+				scanArgs[index] = gomock.Any()
+			}
+
+			switch intention {
+			case "simple":
+				rowsCount = 2
+				mockRows.EXPECT().Scan(scanArgs...).DoAndReturn(func(pointers ...any) error {
+					*pointers[0].(*model.Identifier) = 1
+					*pointers[1].(*time.Time) = now
+					*pointers[2].(*string) = "pending"
+					*pointers[5].(*[]byte) = []byte("[]")
+					*pointers[6].(*model.Identifier) = 1
+					*pointers[7].(*string) = "nobody@localhost"
+					*pointers[9].(*string) = "email"
+
+					return nil
+				})
+				mockRows.EXPECT().Scan(scanArgs...).DoAndReturn(func(pointers ...any) error {
+					*pointers[0].(*model.Identifier) = 2
+					*pointers[2].(*string) = "failed"
+					*pointers[3].(*uint) = 1
+					*pointers[4].(*string) = "timeout"
+					*pointers[5].(*[]byte) = []byte("[]")
+					*pointers[6].(*model.Identifier) = 1
+					*pointers[7].(*string) = "nobody@localhost"
+					*pointers[8].(*model.Identifier) = 3
+					*pointers[9].(*string) = "slack"
+					*pointers[10].(*string) = "https://hooks.slack.com/services/T0/B0/X"
+					*pointers[12].(*[]string) = []string{"daily"}
+
+					return nil
+				})
+			case "invalid status":
+				rowsCount = 1
+				mockRows.EXPECT().Scan(scanArgs...).DoAndReturn(func(pointers ...any) error {
+					*pointers[0].(*model.Identifier) = 1
+					*pointers[2].(*string) = "lost"
+
+					return nil
+				})
+			}
+
+			dummyFn := func(_ context.Context, scanner func(pgx.Rows) error, _ string, _ ...any) error {
+				for range rowsCount {
+					if err := scanner(mockRows); err != nil {
+						return err
+					}
+				}
+
+				return nil
+			}
+			mockDatabase.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any(), testCase.args.maxAttempts, testCase.args.now).DoAndReturn(dummyFn)
+
+			got, gotErr := instance.ListDue(context.TODO(), testCase.args.now, testCase.args.maxAttempts)
+
+			failed := false
+
+			if !errors.Is(gotErr, testCase.wantErr) {
+				failed = true
+			} else if testCase.wantErr == nil && !reflect.DeepEqual(got, testCase.want) {
+				failed = true
+			}
+
+			if failed {
+				t.Errorf("ListDue() = (%+v, `%s`), want (%+v, `%s`)", got, gotErr, testCase.want, testCase.wantErr)
+			}
+		})
+	}
+}
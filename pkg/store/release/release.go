@@ -0,0 +1,286 @@
+package release
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ViBiOh/ketchup/pkg/model"
+	"github.com/ViBiOh/ketchup/pkg/semver"
+	"github.com/jackc/pgx/v5"
+)
+
+type Service struct {
+	db model.Database
+}
+
+func New(db model.Database) Service {
+	return Service{
+		db: db,
+	}
+}
+
+func (s Service) DoAtomic(ctx context.Context, action func(context.Context) error) error {
+	return s.db.DoAtomic(ctx, action)
+}
+
+const insertQuery = `
+INSERT INTO
+  ketchup.release
+(
+  repository_id,
+  pattern,
+  version,
+  published_at,
+  notes
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5
+) ON CONFLICT (repository_id, pattern, version) DO NOTHING
+`
+
+func (s Service) Create(ctx context.Context, o model.Release) error {
+	var publishedAt *time.Time
+	if !o.PublishedAt.IsZero() {
+		publishedAt = &o.PublishedAt
+	}
+
+	return s.db.Exec(ctx, insertQuery, o.Repository.ID, o.Pattern, o.Version.Name, publishedAt, o.Notes)
+}
+
+const listByUserQuery = `
+SELECT
+  r.id,
+  r.kind,
+  r.name,
+  r.part,
+  rl.pattern,
+  rl.version,
+  rl.detected_at,
+  rl.published_at,
+  rl.notes,
+  k.version
+FROM
+  ketchup.release rl,
+  ketchup.ketchup k,
+  ketchup.repository r
+WHERE
+  k.user_id = $1
+  AND k.repository_id = rl.repository_id
+  AND k.pattern = rl.pattern
+  AND r.id = rl.repository_id
+ORDER BY
+  rl.detected_at DESC,
+  r.name ASC
+LIMIT $2
+`
+
+func (s Service) ListByUser(ctx context.Context, userID model.Identifier, count uint) ([]model.Release, error) {
+	var list []model.Release
+
+	scanner := func(rows pgx.Rows) error {
+		var item model.Release
+		var rawRepositoryKind, version string
+		var publishedAt *time.Time
+
+		if err := rows.Scan(&item.Repository.ID, &rawRepositoryKind, &item.Repository.Name, &item.Repository.Part, &item.Pattern, &version, &item.DetectedAt, &publishedAt, &item.Notes, &item.Current); err != nil {
+			return err
+		}
+
+		if publishedAt != nil {
+			item.PublishedAt = *publishedAt
+		}
+
+		repositoryKind, err := model.ParseRepositoryKind(rawRepositoryKind)
+		if err != nil {
+			return fmt.Errorf("parse kind `%s`: %w", rawRepositoryKind, err)
+		}
+
+		item.Repository.Kind = repositoryKind
+		item.Repository.Versions = make(map[string]string)
+		item.Version = semver.Version{Name: version}
+		item.URL = item.Repository.VersionURL(version)
+
+		list = append(list, item)
+
+		return nil
+	}
+
+	return list, s.db.List(ctx, scanner, listByUserQuery, userID, count)
+}
+
+const yankQuery = `
+UPDATE
+  ketchup.release
+SET
+  yanked_at = now()
+WHERE
+  repository_id = $1
+  AND version = $2
+  AND yanked_at IS NULL
+`
+
+const insertYankedQuery = `
+INSERT INTO
+  ketchup.release
+(
+  repository_id,
+  pattern,
+  version,
+  notified_at,
+  yanked_at
+)
+SELECT
+  $1,
+  '',
+  $2,
+  now(),
+  now()
+WHERE
+  NOT EXISTS (
+    SELECT
+      1
+    FROM
+      ketchup.release
+    WHERE
+      repository_id = $1
+      AND version = $2
+  )
+`
+
+// Yank flags the version as withdrawn, recording it when it was released before being tracked
+func (s Service) Yank(ctx context.Context, o model.Release) error {
+	if err := s.db.Exec(ctx, yankQuery, o.Repository.ID, o.Version.Name); err != nil {
+		return fmt.Errorf("yank: %w", err)
+	}
+
+	return s.db.Exec(ctx, insertYankedQuery, o.Repository.ID, o.Version.Name)
+}
+
+const listPendingQuery = `
+SELECT
+  DISTINCT ON (rl.repository_id, rl.pattern)
+  r.id,
+  r.kind,
+  r.name,
+  r.part,
+  rl.pattern,
+  rl.version,
+  rl.detected_at,
+  rl.published_at,
+  rl.notes
+FROM
+  ketchup.release rl,
+  ketchup.repository r
+WHERE
+  rl.notified_at IS NULL
+  AND rl.yanked_at IS NULL
+  AND r.id = rl.repository_id
+ORDER BY
+  rl.repository_id ASC,
+  rl.pattern ASC,
+  rl.detected_at DESC
+`
+
+// ListPending lists the latest release of each repository's pattern not notified yet
+func (s Service) ListPending(ctx context.Context) ([]model.Release, error) {
+	var list []model.Release
+
+	scanner := func(rows pgx.Rows) error {
+		var item model.Release
+		var rawRepositoryKind, version string
+		var publishedAt *time.Time
+
+		if err := rows.Scan(&item.Repository.ID, &rawRepositoryKind, &item.Repository.Name, &item.Repository.Part, &item.Pattern, &version, &item.DetectedAt, &publishedAt, &item.Notes); err != nil {
+			return err
+		}
+
+		if publishedAt != nil {
+			item.PublishedAt = *publishedAt
+		}
+
+		repositoryKind, err := model.ParseRepositoryKind(rawRepositoryKind)
+		if err != nil {
+			return fmt.Errorf("parse kind `%s`: %w", rawRepositoryKind, err)
+		}
+
+		item.Repository.Kind = repositoryKind
+		item.Repository.Versions = make(map[string]string)
+		item.Version = semver.Version{Name: version}
+		item.URL = item.Repository.VersionURL(version)
+
+		list = append(list, item)
+
+		return nil
+	}
+
+	return list, s.db.List(ctx, scanner, listPendingQuery)
+}
+
+const listYankedQuery = `
+SELECT
+  DISTINCT ON (rl.repository_id, rl.version)
+  r.id,
+  r.kind,
+  r.name,
+  r.part,
+  rl.version
+FROM
+  ketchup.release rl,
+  ketchup.repository r
+WHERE
+  rl.yanked_at IS NOT NULL
+  AND r.id = rl.repository_id
+ORDER BY
+  rl.repository_id ASC,
+  rl.version ASC
+`
+
+func (s Service) ListYanked(ctx context.Context) ([]model.Release, error) {
+	var list []model.Release
+
+	scanner := func(rows pgx.Rows) error {
+		var item model.Release
+		var rawRepositoryKind, version string
+
+		if err := rows.Scan(&item.Repository.ID, &rawRepositoryKind, &item.Repository.Name, &item.Repository.Part, &version); err != nil {
+			return err
+		}
+
+		repositoryKind, err := model.ParseRepositoryKind(rawRepositoryKind)
+		if err != nil {
+			return fmt.Errorf("parse kind `%s`: %w", rawRepositoryKind, err)
+		}
+
+		item.Repository.Kind = repositoryKind
+		item.Repository.Versions = make(map[string]string)
+		item.Version = semver.Version{Name: version}
+		item.URL = item.Repository.VersionURL(version)
+
+		list = append(list, item)
+
+		return nil
+	}
+
+	return list, s.db.List(ctx, scanner, listYankedQuery)
+}
+
+const markNotifiedQuery = `
+UPDATE
+  ketchup.release
+SET
+  notified_at = now()
+WHERE
+  repository_id = $1
+  AND pattern = $2
+  AND notified_at IS NULL
+  AND detected_at <= $3
+`
+
+// MarkNotified flags the release and the older ones of its pattern as notified, leaving those detected since it was listed
+func (s Service) MarkNotified(ctx context.Context, o model.Release) error {
+	return s.db.Exec(ctx, markNotifiedQuery, o.Repository.ID, o.Pattern, o.DetectedAt)
+}
@@ -0,0 +1,292 @@
+package release
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ViBiOh/ketchup/pkg/mocks"
+	"github.com/ViBiOh/ketchup/pkg/model"
+	"github.com/ViBiOh/ketchup/pkg/semver"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListByUser(t *testing.T) {
+	t.Parallel()
+
+	detectedAt := time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)
+	publishedAt := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	type args struct {
+		userID model.Identifier
+		count  uint
+	}
+
+	cases := map[string]struct {
+		args    args
+		want    []model.Release
+		wantErr error
+	}{
+		"simple": {
+			args{
+				userID: 1,
+				count:  50,
+			},
+			[]model.Release{
+				{
+					Repository:  model.Repository{ID: 1, Kind: model.Github, Name: "vibioh/ketchup", Versions: map[string]string{}},
+					Pattern:     model.DefaultPattern,
+					Version:     semver.Version{Name: "1.1.0"},
+					URL:         "https://github.com/vibioh/ketchup/releases/tag/1.1.0",
+					Current:     "1.0.0",
+					DetectedAt:  detectedAt,
+					PublishedAt: publishedAt,
+					Notes:       "Bug fixes",
+				},
+			},
+			nil,
+		},
+		"invalid kind": {
+			args{
+				userID: 1,
+				count:  50,
+			},
+			nil,
+			model.ErrUnknownRepositoryKind,
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			mockDatabase := mocks.NewDatabase(ctrl)
+
+			instance := Service{db: mockDatabase}
+
+			mockRows := mocks.NewRows(ctrl)
+
+			switch intention {
+			case "simple":
+				mockRows.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(pointers ...any) error {
+					*pointers[0].(*model.Identifier) = 1
+					*pointers[1].(*string) = "github"
+					*pointers[2].(*string) = "vibioh/ketchup"
+					*pointers[3].(*string) = ""
+					*pointers[4].(*string) = model.DefaultPattern
+					*pointers[5].(*string) = "1.1.0"
+					*pointers[6].(*time.Time) = detectedAt
+					*pointers[7].(**time.Time) = &publishedAt
+					*pointers[8].(*string) = "Bug fixes"
+					*pointers[9].(*string) = "1.0.0"
+
+					return nil
+				})
+			case "invalid kind":
+				mockRows.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(pointers ...any) error {
+					*pointers[0].(*model.Identifier) = 1
+					*pointers[1].(*string) = "wrong"
+
+					return nil
+				})
+			}
+
+			dummyFn := func(_ context.Context, scanner func(pgx.Rows) error, _ string, _ ...any) error {
+				return scanner(mockRows)
+			}
+			mockDatabase.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any(), testCase.args.userID, testCase.args.count).DoAndReturn(dummyFn)
+
+			got, gotErr := instance.ListByUser(context.TODO(), testCase.args.userID, testCase.args.count)
+
+			failed := false
+
+			if !errors.Is(gotErr, testCase.wantErr) {
+				failed = true
+			} else if testCase.wantErr == nil && !reflect.DeepEqual(got, testCase.want) {
+				failed = true
+			}
+
+			if failed {
+				t.Errorf("ListByUser() = (%+v, `%s`), want (%+v, `%s`)", got, gotErr, testCase.want, testCase.wantErr)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	t.Parallel()
+
+	publishedAt := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	repository := model.NewGithubRepository(model.Identifier(1), "vibioh/ketchup")
+
+	type args struct {
+		o model.Release
+	}
+
+	cases := map[string]struct {
+		args            args
+		wantPublishedAt *time.Time
+	}{
+		"without detail": {
+			args{
+				o: model.NewRelease(repository, model.DefaultPattern, semver.Version{Name: "1.1.0"}),
+			},
+			nil,
+		},
+		"with detail": {
+			args{
+				o: model.NewRelease(repository, model.DefaultPattern, semver.Version{Name: "1.1.0"}).SetDetail(model.ReleaseDetail{PublishedAt: publishedAt, Notes: "Bug fixes"}),
+			},
+			&publishedAt,
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			mockDatabase := mocks.NewDatabase(ctrl)
+
+			instance := Service{db: mockDatabase}
+
+			mockDatabase.EXPECT().Exec(gomock.Any(), gomock.Any(), model.Identifier(1), model.DefaultPattern, "1.1.0", testCase.wantPublishedAt, testCase.args.o.Notes).Return(nil)
+
+			if gotErr := instance.Create(context.TODO(), testCase.args.o); gotErr != nil {
+				t.Errorf("Create() = `%s`, want nil", gotErr)
+			}
+		})
+	}
+}
+
+func TestListPending(t *testing.T) {
+	t.Parallel()
+
+	detectedAt := time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)
+
+	cases := map[string]struct {
+		want    []model.Release
+		wantErr error
+	}{
+		"simple": {
+			[]model.Release{
+				{
+					Repository: model.Repository{ID: 1, Kind: model.Github, Name: "vibioh/ketchup", Versions: map[string]string{}},
+					Pattern:    model.DefaultPattern,
+					Version:    semver.Version{Name: "1.1.0"},
+					URL:        "https://github.com/vibioh/ketchup/releases/tag/1.1.0",
+					DetectedAt: detectedAt,
+				},
+			},
+			nil,
+		},
+		"invalid kind": {
+			nil,
+			model.ErrUnknownRepositoryKind,
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			mockDatabase := mocks.NewDatabase(ctrl)
+
+			instance := Service{db: mockDatabase}
+
+			mockRows := mocks.NewRows(ctrl)
+
+			switch intention {
+			case "simple":
+				mockRows.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(pointers ...any) error {
+					*pointers[0].(*model.Identifier) = 1
+					*pointers[1].(*string) = "github"
+					*pointers[2].(*string) = "vibioh/ketchup"
+					*pointers[3].(*string) = ""
+					*pointers[4].(*string) = model.DefaultPattern
+					*pointers[5].(*string) = "1.1.0"
+					*pointers[6].(*time.Time) = detectedAt
+
+					return nil
+				})
+			case "invalid kind":
+				mockRows.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(pointers ...any) error {
+					*pointers[0].(*model.Identifier) = 1
+					*pointers[1].(*string) = "wrong"
+
+					return nil
+				})
+			}
+
+			dummyFn := func(_ context.Context, scanner func(pgx.Rows) error, _ string, _ ...any) error {
+				return scanner(mockRows)
+			}
+			mockDatabase.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(dummyFn)
+
+			got, gotErr := instance.ListPending(context.TODO())
+
+			failed := false
+
+			if !errors.Is(gotErr, testCase.wantErr) {
+				failed = true
+			} else if testCase.wantErr == nil && !reflect.DeepEqual(got, testCase.want) {
+				failed = true
+			}
+
+			if failed {
+				t.Errorf("ListPending() = (%+v, `%s`), want (%+v, `%s`)", got, gotErr, testCase.want, testCase.wantErr)
+			}
+		})
+	}
+}
+
+func TestYank(t *testing.T) {
+	t.Parallel()
+
+	release := model.NewRelease(model.NewGithubRepository(model.Identifier(1), "vibioh/ketchup"), "", semver.Version{Name: "1.0.0"}.Yank())
+
+	cases := map[string]struct {
+		wantErr error
+	}{
+		"success": {
+			nil,
+		},
+		"update error": {
+			errors.New("timeout"),
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			mockDatabase := mocks.NewDatabase(ctrl)
+
+			instance := Service{db: mockDatabase}
+
+			switch intention {
+			case "success":
+				mockDatabase.EXPECT().Exec(gomock.Any(), yankQuery, model.Identifier(1), "1.0.0").Return(nil)
+				mockDatabase.EXPECT().Exec(gomock.Any(), insertYankedQuery, model.Identifier(1), "1.0.0").Return(nil)
+			case "update error":
+				mockDatabase.EXPECT().Exec(gomock.Any(), yankQuery, model.Identifier(1), "1.0.0").Return(errors.New("timeout"))
+			}
+
+			gotErr := instance.Yank(context.TODO(), release)
+
+			if (gotErr == nil) != (testCase.wantErr == nil) {
+				t.Errorf("Yank() = `%s`, want `%s`", gotErr, testCase.wantErr)
+			}
+		})
+	}
+}
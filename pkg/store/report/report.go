@@ -0,0 +1,90 @@
+package report
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/ViBiOh/ketchup/pkg/model"
+	"github.com/jackc/pgx/v5"
+)
+
+type Service struct {
+	db model.Database
+}
+
+func New(db model.Database) Service {
+	return Service{
+		db: db,
+	}
+}
+
+const insertQuery = `
+INSERT INTO
+  ketchup.report
+(
+  name,
+  started_at,
+  content
+) VALUES (
+  $1,
+  $2,
+  $3
+)
+`
+
+func (s Service) Create(ctx context.Context, o model.Report) error {
+	content, err := json.Marshal(o)
+	if err != nil {
+		return fmt.Errorf("marshal: %w", err)
+	}
+
+	return s.db.Exec(ctx, insertQuery, o.Name, o.StartedAt, content)
+}
+
+const deleteBeforeQuery = `
+DELETE FROM
+  ketchup.report
+WHERE
+  started_at < $1
+`
+
+func (s Service) DeleteBefore(ctx context.Context, date time.Time) error {
+	return s.db.Exec(ctx, deleteBeforeQuery, date)
+}
+
+const listLatestQuery = `
+SELECT
+  DISTINCT ON (name)
+  content
+FROM
+  ketchup.report
+ORDER BY
+  name ASC,
+  started_at DESC
+`
+
+// ListLatest gives the last report of each name
+func (s Service) ListLatest(ctx context.Context) ([]model.Report, error) {
+	var list []model.Report
+
+	scanner := func(rows pgx.Rows) error {
+		var content []byte
+
+		if err := rows.Scan(&content); err != nil {
+			return err
+		}
+
+		var item model.Report
+		if err := json.Unmarshal(content, &item); err != nil {
+			return fmt.Errorf("unmarshal: %w", err)
+		}
+
+		list = append(list, item)
+
+		return nil
+	}
+
+	return list, s.db.List(ctx, scanner, listLatestQuery)
+}
@@ -95,11 +95,21 @@ WHERE
   TRUE
 `
 
-func (s Service) List(ctx context.Context, pageSize uint, lastID model.Identifier) ([]model.Repository, error) {
+func (s Service) List(ctx context.Context, pageSize uint, lastID model.Identifier, kinds ...model.RepositoryKind) ([]model.Repository, error) {
 	var query strings.Builder
 	query.WriteString(listQuery)
 	var queryArgs []any
 
+	if len(kinds) != 0 {
+		kindsStr := make([]string, len(kinds))
+		for i, kind := range kinds {
+			kindsStr[i] = strings.ToLower(kind.String())
+		}
+
+		queryArgs = append(queryArgs, kindsStr)
+		fmt.Fprintf(&query, " AND kind = ANY($%d)", len(queryArgs))
+	}
+
 	if lastID != 0 {
 		queryArgs = append(queryArgs, lastID)
 		fmt.Fprintf(&query, " AND id > $%d", len(queryArgs))
@@ -25,6 +25,7 @@ func TestList(t *testing.T) {
 	type args struct {
 		pageSize uint
 		last     model.Identifier
+		kinds    []model.RepositoryKind
 	}
 
 	cases := map[string]struct {
@@ -53,6 +54,15 @@ func TestList(t *testing.T) {
 			0,
 			nil,
 		},
+		"kinds": {
+			args{
+				pageSize: 20,
+				kinds:    []model.RepositoryKind{model.NPM, model.Pypi},
+			},
+			nil,
+			0,
+			nil,
+		},
 		"error": {
 			args{
 				pageSize: 20,
@@ -142,6 +152,9 @@ func TestList(t *testing.T) {
 			case "last":
 				mockDatabase.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any(), model.Identifier(2), uint(20)).Return(nil)
 
+			case "kinds":
+				mockDatabase.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any(), []string{"npm", "pypi"}, uint(20)).Return(nil)
+
 			case "error":
 				mockDatabase.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any(), uint(20)).Return(errors.New("timeout"))
 
@@ -177,7 +190,7 @@ func TestList(t *testing.T) {
 				mockDatabase.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any(), uint(20)).DoAndReturn(dummyFn)
 			}
 
-			got, gotErr := instance.List(context.TODO(), testCase.args.pageSize, testCase.args.last)
+			got, gotErr := instance.List(context.TODO(), testCase.args.pageSize, testCase.args.last, testCase.args.kinds...)
 			failed := false
 
 			if testCase.wantErr == nil && gotErr != nil {
@@ -25,7 +25,10 @@ const getByEmailQuery = `
 SELECT
   id,
   email,
-  user_id
+  user_id,
+  timezone,
+  notification_hour,
+  weekly_day
 FROM
   ketchup.user
 WHERE
@@ -36,7 +39,7 @@ func (s Service) GetByEmail(ctx context.Context, email string) (model.User, erro
 	var item model.User
 
 	scanner := func(row pgx.Row) (err error) {
-		switch err = row.Scan(&item.ID, &item.Email, &item.Base.ID); err {
+		switch err = row.Scan(&item.ID, &item.Email, &item.Base.ID, &item.Timezone, &item.Hour, &item.WeeklyDay); err {
 		case pgx.ErrNoRows:
 			err = nil
 		}
@@ -51,7 +54,10 @@ const getByLoginIDQuery = `
 SELECT
   id,
   email,
-  login_id
+  login_id,
+  timezone,
+  notification_hour,
+  weekly_day
 FROM
   ketchup.user
 WHERE
@@ -62,7 +68,7 @@ func (s Service) GetByLoginID(ctx context.Context, loginID string) (model.User,
 	var item model.User
 
 	scanner := func(row pgx.Row) (err error) {
-		switch err = row.Scan(&item.ID, &item.Email, &item.Base.ID); err {
+		switch err = row.Scan(&item.ID, &item.Email, &item.Base.ID, &item.Timezone, &item.Hour, &item.WeeklyDay); err {
 		case pgx.ErrNoRows:
 			return nil
 		}
@@ -105,3 +111,84 @@ func (s Service) Count(ctx context.Context) (uint64, error) {
 		return row.Scan(&count)
 	}, countQuery)
 }
+
+const getByFeedTokenQuery = `
+SELECT
+  id,
+  email,
+  login_id,
+  timezone,
+  notification_hour,
+  weekly_day
+FROM
+  ketchup.user
+WHERE
+  feed_token = $1
+`
+
+func (s Service) GetByFeedToken(ctx context.Context, token string) (model.User, error) {
+	var item model.User
+
+	scanner := func(row pgx.Row) (err error) {
+		switch err = row.Scan(&item.ID, &item.Email, &item.Base.ID, &item.Timezone, &item.Hour, &item.WeeklyDay); err {
+		case pgx.ErrNoRows:
+			return nil
+		}
+
+		return err
+	}
+
+	return item, s.db.Get(ctx, scanner, getByFeedTokenQuery, token)
+}
+
+const getFeedTokenQuery = `
+SELECT
+  COALESCE(feed_token, '')
+FROM
+  ketchup.user
+WHERE
+  id = $1
+`
+
+func (s Service) GetFeedToken(ctx context.Context, id model.Identifier) (string, error) {
+	var token string
+
+	scanner := func(row pgx.Row) (err error) {
+		switch err = row.Scan(&token); err {
+		case pgx.ErrNoRows:
+			return nil
+		}
+
+		return err
+	}
+
+	return token, s.db.Get(ctx, scanner, getFeedTokenQuery, id)
+}
+
+const updateFeedTokenQuery = `
+UPDATE
+  ketchup.user
+SET
+  feed_token = $2
+WHERE
+  id = $1
+`
+
+func (s Service) UpdateFeedToken(ctx context.Context, id model.Identifier, token string) error {
+	return s.db.One(ctx, updateFeedTokenQuery, id, token)
+}
+
+const updateSettingsQuery = `
+UPDATE
+  ketchup.user
+SET
+  timezone = $2,
+  notification_hour = $3,
+  weekly_day = $4
+WHERE
+  id = $1
+`
+
+func (s Service) UpdateSettings(ctx context.Context, o model.User) error {
+	return s.db.One(ctx, updateSettingsQuery, o.ID, o.Timezone, o.Hour, o.WeeklyDay)
+}
@@ -0,0 +1,37 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/ViBiOh/ketchup/pkg/model"
+)
+
+type Service struct {
+	db model.Database
+}
+
+func New(db model.Database) Service {
+	return Service{
+		db: db,
+	}
+}
+
+const insertDeliveryQuery = `
+INSERT INTO
+  ketchup.webhook_delivery
+(
+  user_id,
+  url,
+  status_code,
+  error
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4
+)
+`
+
+func (s Service) CreateDelivery(ctx context.Context, o model.WebhookDelivery) error {
+	return s.db.Exec(ctx, insertDeliveryQuery, o.UserID, o.URL, o.StatusCode, o.Error)
+}
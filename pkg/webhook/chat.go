@@ -0,0 +1,220 @@
+package webhook
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/ViBiOh/ketchup/pkg/model"
+)
+
+const (
+	yankedTitle = "Yanked versions"
+
+	// According to https://api.slack.com/reference/block-kit/blocks
+	slackMaxBlocks      = 50
+	slackMaxSectionText = 3000
+
+	// According to https://discord.com/developers/docs/resources/message#embed-object-embed-limits
+	discordMaxEmbeds      = 10
+	discordMaxDescription = 4096
+	discordMaxTotal       = 6000
+)
+
+var discordColors = map[string]int{
+	model.Github.String(): 0x24292f,
+	model.Helm.String():   0x0f1689,
+	model.Docker.String(): 0x1d63ed,
+	model.NPM.String():    0xcb3837,
+	model.Pypi.String():   0x3775a9,
+	yankedTitle:           0xf0ad4e,
+}
+
+type releaseGroup struct {
+	title    string
+	releases []model.Release
+}
+
+// groupReleases groups releases by repository kind, in the same order as the email digest, yanked versions last
+func groupReleases(releases []model.Release) []releaseGroup {
+	sorted := slices.Clone(releases)
+	sort.Sort(model.ReleaseByKindAndName(sorted))
+
+	var groups []releaseGroup
+	var yanked []model.Release
+
+	for _, release := range sorted {
+		if release.Version.IsYanked() {
+			yanked = append(yanked, release)
+			continue
+		}
+
+		title := release.Repository.Kind.String()
+		if len(groups) == 0 || groups[len(groups)-1].title != title {
+			groups = append(groups, releaseGroup{title: title})
+		}
+
+		groups[len(groups)-1].releases = append(groups[len(groups)-1].releases, release)
+	}
+
+	if len(yanked) != 0 {
+		groups = append(groups, releaseGroup{title: yankedTitle, releases: yanked})
+	}
+
+	return groups
+}
+
+func releaseLine(release model.Release, link func(text, url string) string) string {
+	name := link(release.Repository.String(), release.Repository.VersionURL(release.Latest()))
+
+	if release.Version.IsYanked() {
+		return fmt.Sprintf("%s `%s` has been yanked", name, release.Version.Name)
+	}
+
+	latest := release.Latest()
+	if len(release.Current) == 0 || release.Current == latest {
+		return fmt.Sprintf("%s `%s`", name, latest)
+	}
+
+	return fmt.Sprintf("%s `%s` → %s", name, release.Current, link(latest, release.Repository.CompareURL(release.Current, release.Pattern)))
+}
+
+// chunkLines joins lines in texts not exceeding the given size
+func chunkLines(lines []string, size int) []string {
+	var chunks []string
+	var builder strings.Builder
+
+	for _, line := range lines {
+		if builder.Len() != 0 && builder.Len()+len(line)+1 > size {
+			chunks = append(chunks, builder.String())
+			builder.Reset()
+		}
+
+		if builder.Len() != 0 {
+			builder.WriteString("\n")
+		}
+
+		builder.WriteString(line)
+	}
+
+	if builder.Len() != 0 {
+		chunks = append(chunks, builder.String())
+	}
+
+	return chunks
+}
+
+func summary(releases []model.Release) string {
+	if len(releases) == 1 {
+		return "Ketchup - 1 release"
+	}
+
+	return fmt.Sprintf("Ketchup - %d releases", len(releases))
+}
+
+type slackText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+type slackBlock struct {
+	Text     *slackText  `json:"text,omitempty"`
+	Type     string      `json:"type"`
+	Elements []slackText `json:"elements,omitempty"`
+}
+
+type slackPayload struct {
+	Text   string       `json:"text"`
+	Blocks []slackBlock `json:"blocks"`
+}
+
+var slackEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+func slackLink(text, url string) string {
+	return fmt.Sprintf("<%s|%s>", url, slackEscaper.Replace(text))
+}
+
+func slackMessage(releases []model.Release) slackPayload {
+	payload := slackPayload{
+		Text: summary(releases),
+		Blocks: []slackBlock{{
+			Type: "header",
+			Text: &slackText{Type: "plain_text", Text: summary(releases)},
+		}},
+	}
+
+	for _, group := range groupReleases(releases) {
+		lines := make([]string, len(group.releases))
+		for index, release := range group.releases {
+			lines[index] = "• " + releaseLine(release, slackLink)
+		}
+
+		for _, chunk := range chunkLines(lines, slackMaxSectionText-len(group.title)-4) {
+			if len(payload.Blocks) == slackMaxBlocks-1 {
+				payload.Blocks = append(payload.Blocks, slackBlock{
+					Type:     "context",
+					Elements: []slackText{{Type: "mrkdwn", Text: "Too many releases, see the email digest for the full list."}},
+				})
+
+				return payload
+			}
+
+			payload.Blocks = append(payload.Blocks, slackBlock{
+				Type: "section",
+				Text: &slackText{Type: "mrkdwn", Text: fmt.Sprintf("*%s*\n%s", group.title, chunk)},
+			})
+		}
+	}
+
+	return payload
+}
+
+type discordEmbed struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Color       int    `json:"color"`
+}
+
+type discordPayload struct {
+	Username string         `json:"username"`
+	Content  string         `json:"content"`
+	Embeds   []discordEmbed `json:"embeds"`
+}
+
+func markdownLink(text, url string) string {
+	return fmt.Sprintf("[%s](%s)", text, url)
+}
+
+func discordMessage(releases []model.Release) discordPayload {
+	payload := discordPayload{
+		Username: "Ketchup",
+		Content:  summary(releases),
+	}
+
+	total := 0
+
+	for _, group := range groupReleases(releases) {
+		lines := make([]string, len(group.releases))
+		for index, release := range group.releases {
+			lines[index] = "- " + releaseLine(release, markdownLink)
+		}
+
+		for _, chunk := range chunkLines(lines, discordMaxDescription) {
+			total += len(group.title) + len(chunk)
+
+			if len(payload.Embeds) == discordMaxEmbeds || total > discordMaxTotal {
+				payload.Content += "\nToo many releases, see the email digest for the full list."
+				return payload
+			}
+
+			payload.Embeds = append(payload.Embeds, discordEmbed{
+				Title:       group.title,
+				Description: chunk,
+				Color:       discordColors[group.title],
+			})
+		}
+	}
+
+	return payload
+}
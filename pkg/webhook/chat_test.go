@@ -0,0 +1,214 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ViBiOh/ketchup/pkg/model"
+	"github.com/ViBiOh/ketchup/pkg/semver"
+)
+
+func safeParse(version string) semver.Version {
+	output, err := semver.Parse(version, "")
+	if err != nil {
+		fmt.Println(err)
+	}
+	return output
+}
+
+func TestGroupReleases(t *testing.T) {
+	t.Parallel()
+
+	github := model.NewGithubRepository(model.Identifier(1), "vibioh/ketchup")
+	npm := model.NewRepository(model.Identifier(2), model.NPM, "left-pad", "")
+	docker := model.NewRepository(model.Identifier(3), model.Docker, "vibioh/viws", "")
+
+	type args struct {
+		releases []model.Release
+	}
+
+	cases := map[string]struct {
+		args args
+		want []string
+	}{
+		"empty": {
+			args{},
+			nil,
+		},
+		"by kind": {
+			args{
+				releases: []model.Release{
+					model.NewRelease(npm, model.DefaultPattern, safeParse("1.0.0").Yank()),
+					model.NewRelease(docker, model.DefaultPattern, safeParse("1.0.0")),
+					model.NewRelease(github, model.DefaultPattern, safeParse("1.0.0")),
+					model.NewRelease(npm, model.DefaultPattern, safeParse("1.1.0")),
+				},
+			},
+			[]string{"Github", "Docker", "NPM", yankedTitle},
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+			for _, group := range groupReleases(testCase.args.releases) {
+				got = append(got, group.title)
+			}
+
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("groupReleases() = %+v, want %+v", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestReleaseLine(t *testing.T) {
+	t.Parallel()
+
+	repository := model.NewGithubRepository(model.Identifier(1), "vibioh/ketchup").AddVersion(model.DefaultPattern, "1.1.0")
+
+	type args struct {
+		release model.Release
+	}
+
+	cases := map[string]struct {
+		args args
+		want string
+	}{
+		"new release": {
+			args{
+				release: model.NewRelease(repository, model.DefaultPattern, safeParse("1.1.0")).SetCurrent("1.0.0"),
+			},
+			"[vibioh/ketchup](https://github.com/vibioh/ketchup/releases/tag/1.1.0) `1.0.0` → [1.1.0](https://github.com/vibioh/ketchup/compare/1.0.0...1.1.0)",
+		},
+		"weekly reminder": {
+			args{
+				release: model.NewRelease(repository, model.DefaultPattern, safeParse("1.0.0")).SetCurrent("1.0.0"),
+			},
+			"[vibioh/ketchup](https://github.com/vibioh/ketchup/releases/tag/1.1.0) `1.0.0` → [1.1.0](https://github.com/vibioh/ketchup/compare/1.0.0...1.1.0)",
+		},
+		"no current": {
+			args{
+				release: model.NewRelease(repository, model.DefaultPattern, safeParse("1.1.0")),
+			},
+			"[vibioh/ketchup](https://github.com/vibioh/ketchup/releases/tag/1.1.0) `1.1.0`",
+		},
+		"yanked": {
+			args{
+				release: model.NewRelease(repository, model.DefaultPattern, safeParse("1.0.0").Yank()).SetCurrent("1.0.0"),
+			},
+			"[vibioh/ketchup](https://github.com/vibioh/ketchup/releases/tag/1.1.0) `1.0.0` has been yanked",
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			if got := releaseLine(testCase.args.release, markdownLink); got != testCase.want {
+				t.Errorf("releaseLine() = `%s`, want `%s`", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestChunkLines(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		lines []string
+		size  int
+	}
+
+	cases := map[string]struct {
+		args args
+		want []string
+	}{
+		"empty": {
+			args{
+				size: 10,
+			},
+			nil,
+		},
+		"fit": {
+			args{
+				lines: []string{"abc", "def"},
+				size:  10,
+			},
+			[]string{"abc\ndef"},
+		},
+		"split": {
+			args{
+				lines: []string{"abcd", "efgh", "ijkl"},
+				size:  10,
+			},
+			[]string{"abcd\nefgh", "ijkl"},
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			if got := chunkLines(testCase.args.lines, testCase.args.size); !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("chunkLines() = %+v, want %+v", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestGetPayload(t *testing.T) {
+	t.Parallel()
+
+	releases := []model.Release{
+		model.NewRelease(model.NewGithubRepository(model.Identifier(1), "vibioh/ketchup").AddVersion(model.DefaultPattern, "1.1.0"), model.DefaultPattern, safeParse("1.1.0")).SetCurrent("1.0.0"),
+	}
+
+	type args struct {
+		kind model.ChannelKind
+	}
+
+	cases := map[string]struct {
+		args args
+		want string
+	}{
+		"generic": {
+			args{
+				kind: model.Webhook,
+			},
+			`[{"pattern":"stable","url":"https://github.com/vibioh/ketchup/releases/tag/1.1.0","current":"1.0.0"`,
+		},
+		"slack": {
+			args{
+				kind: model.Slack,
+			},
+			`{"text":"Ketchup - 1 release","blocks":[{"text":{"type":"plain_text","text":"Ketchup - 1 release"},"type":"header"},{"text":{"type":"mrkdwn","text":"*Github*\n• \u003chttps://github.com/vibioh/ketchup/releases/tag/1.1.0|vibioh/ketchup\u003e`,
+		},
+		"discord": {
+			args{
+				kind: model.Discord,
+			},
+			`{"username":"Ketchup","content":"Ketchup - 1 release","embeds":[{"title":"Github","description":"- [vibioh/ketchup](https://github.com/vibioh/ketchup/releases/tag/1.1.0)`,
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := getPayload(testCase.args.kind, releases)
+			if err != nil {
+				t.Errorf("getPayload() error = `%s`", err)
+			}
+
+			if !json.Valid(got) || !strings.HasPrefix(string(got), testCase.want) {
+				t.Errorf("getPayload() = `%s`, want prefix `%s`", got, testCase.want)
+			}
+		})
+	}
+}
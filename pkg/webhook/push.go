@@ -0,0 +1,129 @@
+package webhook
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/ViBiOh/ketchup/pkg/model"
+)
+
+// According to https://docs.ntfy.sh/publish/#limitations, longer messages become attachments
+const pushMaxMessage = 4096
+
+type pushPriority int
+
+const (
+	lowPriority pushPriority = iota
+	defaultPriority
+	highPriority
+)
+
+// According to https://docs.ntfy.sh/publish/#message-priority
+var ntfyPriorities = map[pushPriority]string{
+	lowPriority:     "low",
+	defaultPriority: "default",
+	highPriority:    "high",
+}
+
+// According to https://gotify.net/docs/msgextras, Android notifications pop up from priority 8
+var gotifyPriorities = map[pushPriority]int{
+	lowPriority:     2,
+	defaultPriority: 5,
+	highPriority:    8,
+}
+
+// releasesPriority is the priority of the most important change: major upgrades and yanked versions are high, minor ones default, others low
+func releasesPriority(releases []model.Release) pushPriority {
+	output := lowPriority
+
+	for _, release := range releases {
+		if release.Version.IsYanked() {
+			return highPriority
+		}
+
+		switch release.Change() {
+		case "Major":
+			return highPriority
+		case "Minor":
+			output = defaultPriority
+		}
+	}
+
+	return output
+}
+
+func pushMessage(releases []model.Release) string {
+	var lines []string
+
+	for _, group := range groupReleases(releases) {
+		for _, release := range group.releases {
+			lines = append(lines, "- "+releaseLine(release, markdownLink))
+		}
+	}
+
+	chunks := chunkLines(lines, pushMaxMessage-4)
+	if len(chunks) == 0 {
+		return ""
+	}
+
+	if len(chunks) > 1 {
+		return chunks[0] + "\n..."
+	}
+
+	return chunks[0]
+}
+
+func ntfyRequest(channel model.Channel, releases []model.Release) webhookRequest {
+	headers := map[string]string{
+		"Title":    summary(releases),
+		"Priority": ntfyPriorities[releasesPriority(releases)],
+		"Tags":     "package",
+		"Markdown": "yes",
+	}
+
+	if len(releases) == 1 {
+		headers["Click"] = releases[0].Repository.VersionURL(releases[0].Latest())
+	}
+
+	if len(channel.Secret) != 0 {
+		headers["Authorization"] = "Bearer " + channel.Secret
+	}
+
+	return webhookRequest{
+		url:     channel.URL,
+		headers: headers,
+		payload: []byte(pushMessage(releases)),
+	}
+}
+
+type gotifyPayload struct {
+	Extras   map[string]any `json:"extras"`
+	Title    string         `json:"title"`
+	Message  string         `json:"message"`
+	Priority int            `json:"priority"`
+}
+
+func gotifyRequest(channel model.Channel, releases []model.Release) (webhookRequest, error) {
+	payload, err := json.Marshal(gotifyPayload{
+		Title:    summary(releases),
+		Message:  pushMessage(releases),
+		Priority: gotifyPriorities[releasesPriority(releases)],
+		Extras: map[string]any{
+			"client::display": map[string]string{
+				"contentType": "text/markdown",
+			},
+		},
+	})
+	if err != nil {
+		return webhookRequest{}, err
+	}
+
+	return webhookRequest{
+		url: strings.TrimSuffix(channel.URL, "/") + "/message",
+		headers: map[string]string{
+			"Content-Type": "application/json",
+			"X-Gotify-Key": channel.Secret,
+		},
+		payload: payload,
+	}, nil
+}
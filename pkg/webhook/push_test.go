@@ -0,0 +1,183 @@
+package webhook
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/ViBiOh/ketchup/pkg/model"
+)
+
+func TestReleasesPriority(t *testing.T) {
+	t.Parallel()
+
+	repository := model.NewGithubRepository(model.Identifier(1), "vibioh/ketchup")
+
+	type args struct {
+		releases []model.Release
+	}
+
+	cases := map[string]struct {
+		args args
+		want pushPriority
+	}{
+		"empty": {
+			args{},
+			lowPriority,
+		},
+		"patch": {
+			args{
+				releases: []model.Release{
+					model.NewRelease(repository, model.DefaultPattern, safeParse("1.0.1")).SetCurrent("1.0.0"),
+				},
+			},
+			lowPriority,
+		},
+		"minor": {
+			args{
+				releases: []model.Release{
+					model.NewRelease(repository, model.DefaultPattern, safeParse("1.0.1")).SetCurrent("1.0.0"),
+					model.NewRelease(repository, model.DefaultPattern, safeParse("1.1.0")).SetCurrent("1.0.0"),
+				},
+			},
+			defaultPriority,
+		},
+		"major": {
+			args{
+				releases: []model.Release{
+					model.NewRelease(repository, model.DefaultPattern, safeParse("1.1.0")).SetCurrent("1.0.0"),
+					model.NewRelease(repository, model.DefaultPattern, safeParse("2.0.0")).SetCurrent("1.0.0"),
+				},
+			},
+			highPriority,
+		},
+		"yanked": {
+			args{
+				releases: []model.Release{
+					model.NewRelease(repository, model.DefaultPattern, safeParse("1.0.0").Yank()).SetCurrent("1.0.0"),
+				},
+			},
+			highPriority,
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			if got := releasesPriority(testCase.args.releases); got != testCase.want {
+				t.Errorf("releasesPriority() = %d, want %d", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestNtfyRequest(t *testing.T) {
+	t.Parallel()
+
+	repository := model.NewGithubRepository(model.Identifier(1), "vibioh/ketchup").AddVersion(model.DefaultPattern, "2.0.0")
+	release := model.NewRelease(repository, model.DefaultPattern, safeParse("2.0.0")).SetCurrent("1.0.0")
+
+	type args struct {
+		channel  model.Channel
+		releases []model.Release
+	}
+
+	cases := map[string]struct {
+		args        args
+		wantHeaders map[string]string
+		wantPayload string
+	}{
+		"public topic": {
+			args{
+				channel:  model.Channel{Kind: model.Ntfy, URL: "https://ntfy.sh/ketchup"},
+				releases: []model.Release{release},
+			},
+			map[string]string{
+				"Title":    "Ketchup - 1 release",
+				"Priority": "high",
+				"Tags":     "package",
+				"Markdown": "yes",
+				"Click":    "https://github.com/vibioh/ketchup/releases/tag/2.0.0",
+			},
+			"- [vibioh/ketchup](https://github.com/vibioh/ketchup/releases/tag/2.0.0) `1.0.0` → [2.0.0](https://github.com/vibioh/ketchup/compare/1.0.0...2.0.0)",
+		},
+		"access token": {
+			args{
+				channel:  model.Channel{Kind: model.Ntfy, URL: "https://ntfy.sh/ketchup", Secret: "tk_secret"},
+				releases: []model.Release{release, release},
+			},
+			map[string]string{
+				"Title":         "Ketchup - 2 releases",
+				"Priority":      "high",
+				"Tags":          "package",
+				"Markdown":      "yes",
+				"Authorization": "Bearer tk_secret",
+			},
+			"- [vibioh/ketchup](https://github.com/vibioh/ketchup/releases/tag/2.0.0) `1.0.0` → [2.0.0](https://github.com/vibioh/ketchup/compare/1.0.0...2.0.0)\n- [vibioh/ketchup](https://github.com/vibioh/ketchup/releases/tag/2.0.0) `1.0.0` → [2.0.0](https://github.com/vibioh/ketchup/compare/1.0.0...2.0.0)",
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			got := ntfyRequest(testCase.args.channel, testCase.args.releases)
+
+			if got.url != testCase.args.channel.URL || !reflect.DeepEqual(got.headers, testCase.wantHeaders) || string(got.payload) != testCase.wantPayload {
+				t.Errorf("ntfyRequest() = (`%s`, %+v, `%s`), want (`%s`, %+v, `%s`)", got.url, got.headers, got.payload, testCase.args.channel.URL, testCase.wantHeaders, testCase.wantPayload)
+			}
+		})
+	}
+}
+
+func TestGotifyRequest(t *testing.T) {
+	t.Parallel()
+
+	repository := model.NewGithubRepository(model.Identifier(1), "vibioh/ketchup")
+
+	type args struct {
+		channel  model.Channel
+		releases []model.Release
+	}
+
+	cases := map[string]struct {
+		args         args
+		wantURL      string
+		wantPriority int
+	}{
+		"patch": {
+			args{
+				channel:  model.Channel{Kind: model.Gotify, URL: "https://gotify.localhost/", Secret: "token"},
+				releases: []model.Release{model.NewRelease(repository, model.DefaultPattern, safeParse("1.0.1")).SetCurrent("1.0.0")},
+			},
+			"https://gotify.localhost/message",
+			2,
+		},
+		"minor": {
+			args{
+				channel:  model.Channel{Kind: model.Gotify, URL: "https://gotify.localhost", Secret: "token"},
+				releases: []model.Release{model.NewRelease(repository, model.DefaultPattern, safeParse("1.1.0")).SetCurrent("1.0.0")},
+			},
+			"https://gotify.localhost/message",
+			5,
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotErr := gotifyRequest(testCase.args.channel, testCase.args.releases)
+
+			var payload gotifyPayload
+			if gotErr == nil {
+				gotErr = json.Unmarshal(got.payload, &payload)
+			}
+
+			if gotErr != nil || got.url != testCase.wantURL || got.headers["X-Gotify-Key"] != "token" || payload.Priority != testCase.wantPriority {
+				t.Errorf("gotifyRequest() = (`%s`, %+v, %d, `%s`), want (`%s`, %d)", got.url, got.headers, payload.Priority, gotErr, testCase.wantURL, testCase.wantPriority)
+			}
+		})
+	}
+}
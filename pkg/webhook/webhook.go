@@ -0,0 +1,197 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"maps"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/ViBiOh/flags"
+	"github.com/ViBiOh/httputils/v4/pkg/request"
+	"github.com/ViBiOh/ketchup/pkg/model"
+)
+
+const (
+	SignatureHeader = "X-Ketchup-Signature"
+	signaturePrefix = "sha256="
+)
+
+var secretHeaders = []string{"Authorization", "X-Gotify-Key", SignatureHeader}
+
+type Service struct {
+	store  model.WebhookStore
+	client *http.Client
+}
+
+type Config struct {
+	Timeout time.Duration
+}
+
+func Flags(fs *flag.FlagSet, prefix string) *Config {
+	var config Config
+
+	flags.New("Timeout", "Delivery timeout").Prefix(prefix).DocPrefix("webhook").DurationVar(fs, &config.Timeout, 10*time.Second, nil)
+
+	return &config
+}
+
+func New(config *Config, store model.WebhookStore) Service {
+	return Service{
+		store:  store,
+		client: request.CreateClient(config.Timeout, nil),
+	}
+}
+
+func (s Service) Send(ctx context.Context, channel model.Channel, releases []model.Release) error {
+	req, err := getRequest(channel, releases)
+	if err != nil {
+		return fmt.Errorf("build %s payload: %w", channel.Kind, err)
+	}
+
+	statusCode, err := s.post(ctx, req)
+
+	delivery := model.WebhookDelivery{
+		UserID:     channel.User.ID,
+		URL:        req.url,
+		StatusCode: statusCode,
+	}
+
+	if err != nil {
+		delivery.Error = err.Error()
+	}
+
+	if createErr := s.store.CreateDelivery(ctx, delivery); createErr != nil {
+		slog.LogAttrs(ctx, slog.LevelError, "log webhook delivery", slog.String("user", channel.User.String()), slog.Any("error", createErr))
+	}
+
+	if err != nil {
+		return fmt.Errorf("deliver %s webhook to `%s`: %w", channel.Kind, req.url, err)
+	}
+
+	return nil
+}
+
+// Preview renders the request as it would be posted, without the headers carrying the secret of the channel
+func (s Service) Preview(channel model.Channel, releases []model.Release) ([]model.Preview, error) {
+	req, err := getRequest(channel, releases)
+	if err != nil {
+		return nil, fmt.Errorf("build %s payload: %w", channel.Kind, err)
+	}
+
+	var content bytes.Buffer
+	fmt.Fprintf(&content, "POST %s\n", req.url)
+
+	for _, key := range slices.Sorted(maps.Keys(req.headers)) {
+		if !slices.Contains(secretHeaders, key) {
+			fmt.Fprintf(&content, "%s: %s\n", key, req.headers[key])
+		}
+	}
+
+	content.WriteString("\n")
+	content.Write(req.payload)
+
+	return []model.Preview{{Format: "txt", Content: content.Bytes()}}, nil
+}
+
+type webhookRequest struct {
+	headers map[string]string
+	url     string
+	payload []byte
+}
+
+func getRequest(channel model.Channel, releases []model.Release) (webhookRequest, error) {
+	switch channel.Kind {
+	case model.Ntfy:
+		return ntfyRequest(channel, releases), nil
+	case model.Gotify:
+		return gotifyRequest(channel, releases)
+	}
+
+	payload, err := getPayload(channel.Kind, releases)
+	if err != nil {
+		return webhookRequest{}, err
+	}
+
+	headers := map[string]string{
+		"Content-Type": "application/json",
+	}
+
+	if len(channel.Secret) != 0 {
+		headers[SignatureHeader] = Sign(channel.Secret, payload)
+	}
+
+	return webhookRequest{
+		url:     channel.URL,
+		headers: headers,
+		payload: payload,
+	}, nil
+}
+
+func getPayload(kind model.ChannelKind, releases []model.Release) ([]byte, error) {
+	switch kind {
+	case model.Slack:
+		return json.Marshal(slackMessage(releases))
+	case model.Discord:
+		return json.Marshal(discordMessage(releases))
+	default:
+		return json.Marshal(releases)
+	}
+}
+
+func (s Service) post(ctx context.Context, webhookReq webhookRequest) (int, error) {
+	req := request.Post(webhookReq.url).WithClient(s.client)
+
+	for key, value := range webhookReq.headers {
+		req = req.Header(key, value)
+	}
+
+	resp, err := req.Send(ctx, io.NopCloser(bytes.NewReader(webhookReq.payload)))
+
+	var statusCode int
+	if resp != nil {
+		statusCode = resp.StatusCode
+
+		if discardErr := request.DiscardBody(resp.Body); discardErr != nil && err == nil {
+			err = fmt.Errorf("discard body: %w", discardErr)
+		}
+	}
+
+	if err == nil && statusCode >= http.StatusMultipleChoices {
+		err = fmt.Errorf("unexpected status code %d", statusCode)
+	}
+
+	// request.Send already fails on an error status, so the response decides whether it's worth retrying
+	if err != nil && isPermanent(statusCode) {
+		return statusCode, fmt.Errorf("%w: %w", err, model.ErrPermanent)
+	}
+
+	return statusCode, err
+}
+
+// isPermanent checks if the status code rejects the request itself, retrying it giving the same answer, apart from timeouts and rate limits
+func isPermanent(statusCode int) bool {
+	switch statusCode {
+	case http.StatusRequestTimeout, http.StatusTooManyRequests:
+		return false
+	default:
+		return statusCode >= http.StatusBadRequest && statusCode < http.StatusInternalServerError
+	}
+}
+
+// Sign computes the value of the signature header, an hex encoded HMAC-SHA256 of the payload
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
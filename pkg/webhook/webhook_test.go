@@ -0,0 +1,220 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	authModel "github.com/ViBiOh/auth/v3/pkg/model"
+	"github.com/ViBiOh/ketchup/pkg/mocks"
+	"github.com/ViBiOh/ketchup/pkg/model"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFlags(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		want string
+	}{
+		"simple": {
+			"Usage of simple:\n  -timeout duration\n    \t[webhook] Delivery timeout ${SIMPLE_TIMEOUT} (default 10s)\n",
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			fs := flag.NewFlagSet(intention, flag.ContinueOnError)
+			Flags(fs, "")
+
+			var writer strings.Builder
+			fs.SetOutput(&writer)
+			fs.Usage()
+
+			result := writer.String()
+
+			if result != testCase.want {
+				t.Errorf("Flags() = `%s`, want `%s`", result, testCase.want)
+			}
+		})
+	}
+}
+
+func TestSign(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		secret  string
+		payload []byte
+	}
+
+	cases := map[string]struct {
+		args args
+		want string
+	}{
+		"simple": {
+			args{
+				secret:  "It's a Secret to Everybody",
+				payload: []byte("Hello, World!"),
+			},
+			"sha256=757107ea0eb2509fc211221cce984b8a37570b6d7586c22c46f4379c8b043e17",
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			if got := Sign(testCase.args.secret, testCase.args.payload); got != testCase.want {
+				t.Errorf("Sign() = `%s`, want `%s`", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestSend(t *testing.T) {
+	t.Parallel()
+
+	user := model.NewUser(1, "nobody@localhost", authModel.NewUser(""))
+
+	cases := map[string]struct {
+		secret         string
+		statusCode     int
+		wantStatusCode int
+		wantErr        error
+		wantPermanent  bool
+	}{
+		"success": {
+			"secret",
+			http.StatusNoContent,
+			http.StatusNoContent,
+			nil,
+			false,
+		},
+		"server error": {
+			"secret",
+			http.StatusInternalServerError,
+			http.StatusInternalServerError,
+			errors.New("HTTP/500"),
+			false,
+		},
+		"rate limited": {
+			"secret",
+			http.StatusTooManyRequests,
+			http.StatusTooManyRequests,
+			errors.New("HTTP/429"),
+			false,
+		},
+		"gone": {
+			"secret",
+			http.StatusGone,
+			http.StatusGone,
+			errors.New("HTTP/410"),
+			true,
+		},
+		"rejected": {
+			"other",
+			http.StatusNoContent,
+			http.StatusUnauthorized,
+			errors.New("HTTP/401"),
+			true,
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				payload, _ := io.ReadAll(r.Body)
+
+				if r.Header.Get(SignatureHeader) != Sign("secret", payload) {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+
+				w.WriteHeader(testCase.statusCode)
+			}))
+			defer server.Close()
+
+			ctrl := gomock.NewController(t)
+
+			mockWebhookStore := mocks.NewWebhookStore(ctrl)
+
+			instance := Service{
+				store:  mockWebhookStore,
+				client: server.Client(),
+			}
+
+			mockWebhookStore.EXPECT().CreateDelivery(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, delivery model.WebhookDelivery) error {
+				if delivery.StatusCode != testCase.wantStatusCode {
+					t.Errorf("CreateDelivery() status code = %d, want %d", delivery.StatusCode, testCase.wantStatusCode)
+				}
+
+				if delivery.UserID != user.ID {
+					t.Errorf("CreateDelivery() user = %d, want %d", delivery.UserID, user.ID)
+				}
+
+				return nil
+			})
+
+			gotErr := instance.Send(context.TODO(), model.Channel{Kind: model.Webhook, URL: server.URL, Secret: testCase.secret, User: user}, []model.Release{})
+
+			failed := false
+
+			if testCase.wantErr == nil && gotErr != nil {
+				failed = true
+			} else if testCase.wantErr != nil && gotErr == nil {
+				failed = true
+			} else if testCase.wantErr != nil && !strings.Contains(gotErr.Error(), testCase.wantErr.Error()) {
+				failed = true
+			} else if errors.Is(gotErr, model.ErrPermanent) != testCase.wantPermanent {
+				failed = true
+			}
+
+			if failed {
+				t.Errorf("Send() = `%s`, want `%s`", gotErr, testCase.wantErr)
+			}
+		})
+	}
+}
+
+func TestPreview(t *testing.T) {
+	t.Parallel()
+
+	repository := model.NewGithubRepository(model.Identifier(1), "vibioh/ketchup").AddVersion(model.DefaultPattern, "2.0.0")
+	release := model.NewRelease(repository, model.DefaultPattern, safeParse("2.0.0")).SetCurrent("1.0.0")
+
+	cases := map[string]struct {
+		channel model.Channel
+		want    string
+	}{
+		"ntfy": {
+			model.Channel{Kind: model.Ntfy, URL: "https://ntfy.sh/ketchup", Secret: "tk_secret"},
+			"POST https://ntfy.sh/ketchup\nClick: https://github.com/vibioh/ketchup/releases/tag/2.0.0\nMarkdown: yes\nPriority: high\nTags: package\nTitle: Ketchup - 1 release\n\n- [vibioh/ketchup](https://github.com/vibioh/ketchup/releases/tag/2.0.0) `1.0.0` → [2.0.0](https://github.com/vibioh/ketchup/compare/1.0.0...2.0.0)",
+		},
+		"signed webhook": {
+			model.Channel{Kind: model.Webhook, URL: "https://localhost/hook", Secret: "secret"},
+			"POST https://localhost/hook\nContent-Type: application/json\n\n[",
+		},
+	}
+
+	for intention, testCase := range cases {
+		t.Run(intention, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotErr := Service{}.Preview(testCase.channel, []model.Release{release})
+
+			if gotErr != nil || len(got) != 1 || !strings.HasPrefix(string(got[0].Content), testCase.want) || strings.Contains(string(got[0].Content), "secret") {
+				t.Errorf("Preview() = (%+v, `%s`), want `%s`", got, gotErr, testCase.want)
+			}
+		})
+	}
+}
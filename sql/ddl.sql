@@ -1,4 +1,9 @@
 -- clean
+DROP TABLE IF EXISTS ketchup.report;
+DROP TABLE IF EXISTS ketchup.notification;
+DROP TABLE IF EXISTS ketchup.webhook_delivery;
+DROP TABLE IF EXISTS ketchup.release;
+DROP TABLE IF EXISTS ketchup.notification_channel;
 DROP TABLE IF EXISTS ketchup.ketchup;
 DROP TABLE IF EXISTS ketchup.repository_version;
 DROP TABLE IF EXISTS ketchup.repository;
@@ -6,15 +11,31 @@ DROP TABLE IF EXISTS ketchup.user;
 
 DROP TYPE IF EXISTS ketchup.repository_kind;
 DROP TYPE IF EXISTS ketchup.ketchup_frequency;
+DROP TYPE IF EXISTS ketchup.ketchup_severity;
+DROP TYPE IF EXISTS ketchup.channel_kind;
+DROP TYPE IF EXISTS ketchup.notification_status;
 
+DROP INDEX IF EXISTS report_name_started_at;
+DROP INDEX IF EXISTS notification_scheduled_at;
+DROP INDEX IF EXISTS notification_status;
+DROP INDEX IF EXISTS notification_id;
+DROP INDEX IF EXISTS webhook_delivery_user_id;
+DROP INDEX IF EXISTS release_pending;
+DROP INDEX IF EXISTS release_detected_at;
+DROP INDEX IF EXISTS release_id;
+DROP INDEX IF EXISTS notification_channel_user_id;
+DROP INDEX IF EXISTS notification_channel_id;
 DROP INDEX IF EXISTS ketchup_id;
 DROP INDEX IF EXISTS repository_version_id;
 DROP INDEX IF EXISTS repository_repository;
 DROP INDEX IF EXISTS repository_id;
+DROP INDEX IF EXISTS user_feed_token;
 DROP INDEX IF EXISTS user_email;
 DROP INDEX IF EXISTS user_login_id;
 DROP INDEX IF EXISTS user_id;
 
+DROP SEQUENCE IF EXISTS ketchup.notification_seq;
+DROP SEQUENCE IF EXISTS ketchup.notification_channel_seq;
 DROP SEQUENCE IF EXISTS ketchup.repository_seq;
 DROP SEQUENCE IF EXISTS ketchup.user_seq;
 
@@ -26,16 +47,21 @@ CREATE SCHEMA ketchup;
 -- user
 CREATE SEQUENCE ketchup.user_seq;
 CREATE TABLE ketchup.user (
-  id            BIGINT                   NOT NULL DEFAULT nextval('ketchup.user_seq'),
-  email         TEXT                     NOT NULL,
-  login_id      TEXT                     NOT NULL REFERENCES auth.login(id) ON DELETE CASCADE,
-  creation_date TIMESTAMP WITH TIME ZONE          DEFAULT now()
+  id                BIGINT                   NOT NULL DEFAULT nextval('ketchup.user_seq'),
+  email             TEXT                     NOT NULL,
+  login_id          TEXT                     NOT NULL REFERENCES auth.login(id) ON DELETE CASCADE,
+  feed_token        TEXT,
+  timezone          TEXT                     NOT NULL DEFAULT 'Europe/Paris',
+  notification_hour SMALLINT                 NOT NULL DEFAULT 8,
+  weekly_day        SMALLINT                 NOT NULL DEFAULT 1,
+  creation_date     TIMESTAMP WITH TIME ZONE          DEFAULT now()
 );
 ALTER SEQUENCE ketchup.user_seq OWNED BY ketchup.user.id;
 
 CREATE UNIQUE INDEX user_id ON ketchup.user(id);
 CREATE UNIQUE INDEX user_login_id ON ketchup.user(login_id);
 CREATE UNIQUE INDEX user_email ON ketchup.user(email);
+CREATE UNIQUE INDEX user_feed_token ON ketchup.user(feed_token);
 
 -- repository_kind
 CREATE TYPE ketchup.repository_kind AS ENUM ('github', 'helm', 'docker', 'npm', 'pypi');
@@ -63,18 +89,107 @@ CREATE TABLE ketchup.repository_version (
 
 CREATE UNIQUE INDEX repository_version_id ON ketchup.repository_version(repository_id, pattern);
 
+-- release
+CREATE TABLE ketchup.release (
+  repository_id BIGINT                   NOT NULL REFERENCES ketchup.repository(id) ON DELETE CASCADE,
+  pattern       TEXT                     NOT NULL DEFAULT 'stable',
+  version       TEXT                     NOT NULL,
+  detected_at   TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+  published_at  TIMESTAMP WITH TIME ZONE,
+  notes         TEXT                     NOT NULL DEFAULT '',
+  notified_at   TIMESTAMP WITH TIME ZONE,
+  yanked_at     TIMESTAMP WITH TIME ZONE
+);
+
+CREATE UNIQUE INDEX release_id ON ketchup.release(repository_id, pattern, version);
+CREATE INDEX release_detected_at ON ketchup.release(detected_at);
+CREATE INDEX release_pending ON ketchup.release(repository_id, pattern) WHERE notified_at IS NULL;
+
 -- repository_kind
-CREATE TYPE ketchup.ketchup_frequency AS ENUM ('none', 'daily', 'weekly');
+CREATE TYPE ketchup.ketchup_frequency AS ENUM ('none', 'daily', 'weekly', 'monthly', 'immediate');
+
+-- ketchup_severity
+CREATE TYPE ketchup.ketchup_severity AS ENUM ('any', 'minor', 'major');
 
 -- ketchup
 CREATE TABLE ketchup.ketchup (
-  user_id            BIGINT                    NOT NULL REFERENCES ketchup.user(id) ON DELETE CASCADE,
-  repository_id      BIGINT                    NOT NULL REFERENCES ketchup.repository(id) ON DELETE CASCADE,
-  pattern            TEXT                      NOT NULL DEFAULT 'stable',
-  version            TEXT                      NOT NULL,
-  frequency          ketchup.ketchup_frequency NOT NULL DEFAULT 'daily',
-  update_when_notify BOOL                      NOT NULL DEFAULT FALSE,
-  creation_date      TIMESTAMP WITH TIME ZONE           DEFAULT now()
+  user_id               BIGINT                    NOT NULL REFERENCES ketchup.user(id) ON DELETE CASCADE,
+  repository_id         BIGINT                    NOT NULL REFERENCES ketchup.repository(id) ON DELETE CASCADE,
+  pattern               TEXT                      NOT NULL DEFAULT 'stable',
+  version               TEXT                      NOT NULL,
+  frequency             ketchup.ketchup_frequency NOT NULL DEFAULT 'daily',
+  severity              ketchup.ketchup_severity  NOT NULL DEFAULT 'any',
+  update_when_notify    BOOL                      NOT NULL DEFAULT FALSE,
+  cooldown              SMALLINT                  NOT NULL DEFAULT 0,
+  notified_version      TEXT                      NOT NULL DEFAULT '',
+  snoozed_until         TIMESTAMP WITH TIME ZONE,
+  skipped_version       TEXT                      NOT NULL DEFAULT '',
+  yank_notified_version TEXT                      NOT NULL DEFAULT '',
+  reminded_at           TIMESTAMP WITH TIME ZONE  NOT NULL DEFAULT now(),
+  creation_date         TIMESTAMP WITH TIME ZONE           DEFAULT now()
 );
 
 CREATE UNIQUE INDEX ketchup_id ON ketchup.ketchup(user_id, repository_id, pattern);
+
+-- channel_kind
+CREATE TYPE ketchup.channel_kind AS ENUM ('email', 'webhook', 'slack', 'discord', 'ntfy', 'gotify');
+
+-- notification_channel
+CREATE SEQUENCE ketchup.notification_channel_seq;
+CREATE TABLE ketchup.notification_channel (
+  id            BIGINT                      NOT NULL DEFAULT nextval('ketchup.notification_channel_seq'),
+  user_id       BIGINT                      NOT NULL REFERENCES ketchup.user(id) ON DELETE CASCADE,
+  kind          ketchup.channel_kind        NOT NULL DEFAULT 'email',
+  url           TEXT                        NOT NULL DEFAULT '',
+  secret        TEXT                        NOT NULL DEFAULT '',
+  frequencies   ketchup.ketchup_frequency[] NOT NULL DEFAULT '{daily,weekly,monthly,immediate}',
+  creation_date TIMESTAMP WITH TIME ZONE             DEFAULT now()
+);
+ALTER SEQUENCE ketchup.notification_channel_seq OWNED BY ketchup.notification_channel.id;
+
+CREATE UNIQUE INDEX notification_channel_id ON ketchup.notification_channel(id);
+CREATE INDEX notification_channel_user_id ON ketchup.notification_channel(user_id);
+
+-- webhook_delivery
+CREATE TABLE ketchup.webhook_delivery (
+  user_id       BIGINT                   NOT NULL REFERENCES ketchup.user(id) ON DELETE CASCADE,
+  url           TEXT                     NOT NULL,
+  status_code   INTEGER                  NOT NULL DEFAULT 0,
+  error         TEXT                     NOT NULL DEFAULT '',
+  creation_date TIMESTAMP WITH TIME ZONE          DEFAULT now()
+);
+
+CREATE INDEX webhook_delivery_user_id ON ketchup.webhook_delivery(user_id, creation_date);
+
+-- notification_status
+CREATE TYPE ketchup.notification_status AS ENUM ('pending', 'delivered', 'failed');
+
+-- notification
+CREATE SEQUENCE ketchup.notification_seq;
+CREATE TABLE ketchup.notification (
+  id            BIGINT                      NOT NULL DEFAULT nextval('ketchup.notification_seq'),
+  user_id       BIGINT                      NOT NULL REFERENCES ketchup.user(id) ON DELETE CASCADE,
+  channel_id    BIGINT                               REFERENCES ketchup.notification_channel(id) ON DELETE CASCADE,
+  kind          ketchup.channel_kind        NOT NULL DEFAULT 'email',
+  releases      JSONB                       NOT NULL DEFAULT '[]',
+  status        ketchup.notification_status NOT NULL DEFAULT 'pending',
+  attempts      INTEGER                     NOT NULL DEFAULT 0,
+  error         TEXT                        NOT NULL DEFAULT '',
+  scheduled_at  TIMESTAMP WITH TIME ZONE    NOT NULL DEFAULT now(),
+  creation_date TIMESTAMP WITH TIME ZONE             DEFAULT now(),
+  update_date   TIMESTAMP WITH TIME ZONE             DEFAULT now()
+);
+ALTER SEQUENCE ketchup.notification_seq OWNED BY ketchup.notification.id;
+
+CREATE UNIQUE INDEX notification_id ON ketchup.notification(id);
+CREATE INDEX notification_status ON ketchup.notification(status) WHERE status <> 'delivered';
+CREATE INDEX notification_scheduled_at ON ketchup.notification(scheduled_at) WHERE status <> 'delivered';
+
+-- report
+CREATE TABLE ketchup.report (
+  name       TEXT                     NOT NULL,
+  started_at TIMESTAMP WITH TIME ZONE NOT NULL,
+  content    JSONB                    NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX report_name_started_at ON ketchup.report(name, started_at DESC);
@@ -0,0 +1,21 @@
+-- webhook
+CREATE TABLE ketchup.webhook (
+  user_id       BIGINT                   NOT NULL REFERENCES ketchup.user(id) ON DELETE CASCADE,
+  url           TEXT                     NOT NULL,
+  secret        TEXT                     NOT NULL,
+  creation_date TIMESTAMP WITH TIME ZONE          DEFAULT now()
+);
+
+CREATE UNIQUE INDEX webhook_user_id ON ketchup.webhook(user_id);
+
+-- webhook_delivery
+CREATE TABLE ketchup.webhook_delivery (
+  user_id       BIGINT                   NOT NULL REFERENCES ketchup.user(id) ON DELETE CASCADE,
+  url           TEXT                     NOT NULL,
+  status_code   INTEGER                  NOT NULL DEFAULT 0,
+  attempts      INTEGER                  NOT NULL DEFAULT 0,
+  error         TEXT                     NOT NULL DEFAULT '',
+  creation_date TIMESTAMP WITH TIME ZONE          DEFAULT now()
+);
+
+CREATE INDEX webhook_delivery_user_id ON ketchup.webhook_delivery(user_id, creation_date);
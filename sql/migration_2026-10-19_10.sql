@@ -0,0 +1,4 @@
+-- new enum values can't be used in the transaction adding them
+ALTER TABLE ketchup.notification_channel ALTER COLUMN frequencies SET DEFAULT '{daily,weekly,monthly,immediate}';
+
+UPDATE ketchup.notification_channel SET frequencies = '{daily,weekly,monthly,immediate}' WHERE frequencies = '{daily,weekly}';
@@ -0,0 +1,2 @@
+ALTER TABLE ketchup.ketchup ADD COLUMN IF NOT EXISTS cooldown SMALLINT NOT NULL DEFAULT 0;
+ALTER TABLE ketchup.ketchup ADD COLUMN IF NOT EXISTS notified_version TEXT NOT NULL DEFAULT '';
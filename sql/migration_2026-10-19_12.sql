@@ -0,0 +1,4 @@
+-- ketchup_severity
+CREATE TYPE ketchup.ketchup_severity AS ENUM ('any', 'minor', 'major');
+
+ALTER TABLE ketchup.ketchup ADD COLUMN IF NOT EXISTS severity ketchup.ketchup_severity NOT NULL DEFAULT 'any';
@@ -0,0 +1,2 @@
+ALTER TABLE ketchup.ketchup ADD COLUMN IF NOT EXISTS snoozed_until TIMESTAMP WITH TIME ZONE;
+ALTER TABLE ketchup.ketchup ADD COLUMN IF NOT EXISTS skipped_version TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,6 @@
+ALTER TABLE ketchup.release ADD COLUMN IF NOT EXISTS notified_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE ketchup.release ADD COLUMN IF NOT EXISTS yanked_at TIMESTAMP WITH TIME ZONE;
+
+UPDATE ketchup.release SET notified_at = detected_at WHERE notified_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS release_pending ON ketchup.release(repository_id, pattern) WHERE notified_at IS NULL;
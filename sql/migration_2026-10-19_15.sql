@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS ketchup.report (
+  name       TEXT                     NOT NULL,
+  started_at TIMESTAMP WITH TIME ZONE NOT NULL,
+  content    JSONB                    NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX IF NOT EXISTS report_name_started_at ON ketchup.report(name, started_at DESC);
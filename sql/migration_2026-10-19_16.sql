@@ -0,0 +1 @@
+ALTER TABLE ketchup.ketchup ADD COLUMN IF NOT EXISTS yank_notified_version TEXT NOT NULL DEFAULT '';
@@ -0,0 +1 @@
+ALTER TABLE ketchup.ketchup ADD COLUMN IF NOT EXISTS reminded_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now();
@@ -0,0 +1 @@
+ALTER TABLE ketchup.webhook_delivery DROP COLUMN IF EXISTS attempts;
@@ -0,0 +1,10 @@
+CREATE TYPE ketchup.webhook_kind AS ENUM ('generic', 'slack', 'discord');
+
+ALTER TABLE ketchup.webhook
+  ADD COLUMN kind ketchup.webhook_kind NOT NULL DEFAULT 'generic';
+
+ALTER TABLE ketchup.webhook
+  ALTER COLUMN secret SET DEFAULT '';
+
+DROP INDEX IF EXISTS webhook_user_id;
+CREATE UNIQUE INDEX webhook_user_id ON ketchup.webhook(user_id, kind);
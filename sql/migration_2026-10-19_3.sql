@@ -0,0 +1,28 @@
+CREATE TYPE ketchup.channel_kind AS ENUM ('email', 'webhook', 'slack', 'discord');
+
+-- notification_channel
+CREATE SEQUENCE ketchup.notification_channel_seq;
+CREATE TABLE ketchup.notification_channel (
+  id            BIGINT                      NOT NULL DEFAULT nextval('ketchup.notification_channel_seq'),
+  user_id       BIGINT                      NOT NULL REFERENCES ketchup.user(id) ON DELETE CASCADE,
+  kind          ketchup.channel_kind        NOT NULL DEFAULT 'email',
+  url           TEXT                        NOT NULL DEFAULT '',
+  secret        TEXT                        NOT NULL DEFAULT '',
+  frequencies   ketchup.ketchup_frequency[] NOT NULL DEFAULT '{daily,weekly}',
+  creation_date TIMESTAMP WITH TIME ZONE             DEFAULT now()
+);
+ALTER SEQUENCE ketchup.notification_channel_seq OWNED BY ketchup.notification_channel.id;
+
+CREATE UNIQUE INDEX notification_channel_id ON ketchup.notification_channel(id);
+CREATE INDEX notification_channel_user_id ON ketchup.notification_channel(user_id);
+
+-- users having a webhook kept receiving their emails, so they are given both channels
+INSERT INTO ketchup.notification_channel (user_id, kind)
+  SELECT DISTINCT user_id, 'email'::ketchup.channel_kind FROM ketchup.webhook;
+
+INSERT INTO ketchup.notification_channel (user_id, kind, url, secret, creation_date)
+  SELECT user_id, CASE kind WHEN 'generic' THEN 'webhook' ELSE kind::TEXT END::ketchup.channel_kind, url, secret, creation_date FROM ketchup.webhook;
+
+DROP INDEX IF EXISTS webhook_user_id;
+DROP TABLE IF EXISTS ketchup.webhook;
+DROP TYPE IF EXISTS ketchup.webhook_kind;
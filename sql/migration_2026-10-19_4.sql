@@ -0,0 +1,2 @@
+ALTER TYPE ketchup.channel_kind ADD VALUE IF NOT EXISTS 'ntfy';
+ALTER TYPE ketchup.channel_kind ADD VALUE IF NOT EXISTS 'gotify';
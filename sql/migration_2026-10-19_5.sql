@@ -0,0 +1,15 @@
+-- feed token
+ALTER TABLE ketchup.user ADD COLUMN IF NOT EXISTS feed_token TEXT;
+
+CREATE UNIQUE INDEX IF NOT EXISTS user_feed_token ON ketchup.user(feed_token);
+
+-- release history, read by the feed and completed with the publication date and notes by migration _6
+CREATE TABLE IF NOT EXISTS ketchup.release (
+  repository_id BIGINT                   NOT NULL REFERENCES ketchup.repository(id) ON DELETE CASCADE,
+  pattern       TEXT                     NOT NULL DEFAULT 'stable',
+  version       TEXT                     NOT NULL,
+  detected_at   TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS release_id ON ketchup.release(repository_id, pattern, version);
+CREATE INDEX IF NOT EXISTS release_detected_at ON ketchup.release(detected_at);
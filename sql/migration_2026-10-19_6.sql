@@ -0,0 +1,2 @@
+ALTER TABLE ketchup.release ADD COLUMN IF NOT EXISTS published_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE ketchup.release ADD COLUMN IF NOT EXISTS notes TEXT NOT NULL DEFAULT '';
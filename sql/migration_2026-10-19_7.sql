@@ -0,0 +1,21 @@
+-- notification_status
+CREATE TYPE ketchup.notification_status AS ENUM ('pending', 'delivered', 'failed');
+
+-- notification
+CREATE SEQUENCE ketchup.notification_seq;
+CREATE TABLE ketchup.notification (
+  id            BIGINT                      NOT NULL DEFAULT nextval('ketchup.notification_seq'),
+  user_id       BIGINT                      NOT NULL REFERENCES ketchup.user(id) ON DELETE CASCADE,
+  channel_id    BIGINT                               REFERENCES ketchup.notification_channel(id) ON DELETE CASCADE,
+  kind          ketchup.channel_kind        NOT NULL DEFAULT 'email',
+  releases      JSONB                       NOT NULL DEFAULT '[]',
+  status        ketchup.notification_status NOT NULL DEFAULT 'pending',
+  attempts      INTEGER                     NOT NULL DEFAULT 0,
+  error         TEXT                        NOT NULL DEFAULT '',
+  creation_date TIMESTAMP WITH TIME ZONE             DEFAULT now(),
+  update_date   TIMESTAMP WITH TIME ZONE             DEFAULT now()
+);
+ALTER SEQUENCE ketchup.notification_seq OWNED BY ketchup.notification.id;
+
+CREATE UNIQUE INDEX notification_id ON ketchup.notification(id);
+CREATE INDEX notification_status ON ketchup.notification(status) WHERE status <> 'delivered';
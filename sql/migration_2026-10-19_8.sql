@@ -0,0 +1,7 @@
+ALTER TABLE ketchup.user ADD COLUMN IF NOT EXISTS timezone TEXT NOT NULL DEFAULT 'Europe/Paris';
+ALTER TABLE ketchup.user ADD COLUMN IF NOT EXISTS notification_hour SMALLINT NOT NULL DEFAULT 8;
+ALTER TABLE ketchup.user ADD COLUMN IF NOT EXISTS weekly_day SMALLINT NOT NULL DEFAULT 1;
+
+ALTER TABLE ketchup.notification ADD COLUMN IF NOT EXISTS scheduled_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now();
+
+CREATE INDEX IF NOT EXISTS notification_scheduled_at ON ketchup.notification(scheduled_at) WHERE status <> 'delivered';
@@ -0,0 +1,2 @@
+ALTER TYPE ketchup.ketchup_frequency ADD VALUE IF NOT EXISTS 'monthly';
+ALTER TYPE ketchup.ketchup_frequency ADD VALUE IF NOT EXISTS 'immediate';